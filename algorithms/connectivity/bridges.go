@@ -0,0 +1,107 @@
+package connectivity
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/codeYann/go-collections/algorithms/internal/adjmap"
+	"github.com/codeYann/go-collections/stack"
+)
+
+// Edge is an undirected edge between From and To.
+type Edge[V any] struct {
+	From, To V
+}
+
+// cutFrame is a vertex on the DFS stack of an undirected search.
+type cutFrame[V any] struct {
+	adjmap.Frame[V]
+	parent   V
+	isRoot   bool
+	skipped  bool // whether the edge back to parent has been skipped
+	children int  // number of DFS tree children, used to decide whether a root is an articulation point
+}
+
+// lowlink runs an iterative depth-first search over an undirected graph and returns its bridges,
+// in the order they were found, and its articulation points, in ascending order.
+func lowlink[V cmp.Ordered](graph map[V][]V) ([]Edge[V], []V) {
+	all := adjmap.Vertices(graph)
+	disc := make(map[V]int, len(all))
+	low := make(map[V]int, len(all))
+	isCut := make(map[V]bool)
+	var bridges []Edge[V]
+
+	// A vertex is only pushed when it is discovered, so the stack never holds more than len(all) frames.
+	s := stack.NewStack[*cutFrame[V]](uint(len(all)))
+
+	for _, root := range all {
+		if _, ok := disc[root]; ok {
+			continue
+		}
+		disc[root], low[root] = len(disc), len(disc)
+		s.Push(&cutFrame[V]{Frame: adjmap.Frame[V]{Vertex: root}, isRoot: true})
+
+		for !s.IsEmpty() {
+			top, _ := s.Peek()
+			edges := graph[top.Vertex]
+
+			if top.Next < len(edges) {
+				to := edges[top.Next]
+				top.Next++
+				// Skip the tree edge to the parent once; any parallel edge to it is a back edge.
+				if !top.isRoot && !top.skipped && to == top.parent {
+					top.skipped = true
+					continue
+				}
+				if d, ok := disc[to]; ok {
+					low[top.Vertex] = min(low[top.Vertex], d)
+					continue
+				}
+				disc[to], low[to] = len(disc), len(disc)
+				s.Push(&cutFrame[V]{Frame: adjmap.Frame[V]{Vertex: to}, parent: top.Vertex})
+				continue
+			}
+
+			s.Pop()
+			if top.isRoot {
+				if top.children > 1 {
+					isCut[top.Vertex] = true
+				}
+				continue
+			}
+
+			parent, _ := s.Peek()
+			parent.children++
+			low[parent.Vertex] = min(low[parent.Vertex], low[top.Vertex])
+			if low[top.Vertex] > disc[parent.Vertex] {
+				bridges = append(bridges, Edge[V]{From: parent.Vertex, To: top.Vertex})
+			}
+			if !parent.isRoot && low[top.Vertex] >= disc[parent.Vertex] {
+				isCut[parent.Vertex] = true
+			}
+		}
+	}
+
+	cuts := make([]V, 0, len(isCut))
+	for v := range isCut {
+		cuts = append(cuts, v)
+	}
+	slices.Sort(cuts)
+	return bridges, cuts
+}
+
+// Bridges returns the edges of an undirected graph whose removal disconnects their endpoints, in O(V + E).
+// Every edge must be listed in the adjacency of both endpoints. Each bridge is reported once,
+// with From being the endpoint discovered first. Parallel edges are never bridges.
+func Bridges[V cmp.Ordered](graph map[V][]V) []Edge[V] {
+	bridges, _ := lowlink(graph)
+	return bridges
+}
+
+// ArticulationPoints returns, in ascending order, the vertices of an undirected graph whose removal
+// increases the number of connected components, in O(V + E). Every edge must be listed in the
+// adjacency of both endpoints.
+func ArticulationPoints[V cmp.Ordered](graph map[V][]V) []V {
+	_, cuts := lowlink(graph)
+	return cuts
+}
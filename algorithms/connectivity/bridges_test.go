@@ -0,0 +1,151 @@
+package connectivity
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/codeYann/go-collections/algorithms/internal/adjmap"
+)
+
+// undirected builds a symmetric adjacency map from a list of edges.
+func undirected(edges [][2]int) map[int][]int {
+	graph := make(map[int][]int)
+	for _, e := range edges {
+		graph[e[0]] = append(graph[e[0]], e[1])
+		graph[e[1]] = append(graph[e[1]], e[0])
+	}
+	return graph
+}
+
+func TestBridgesAndArticulationPoints(t *testing.T) {
+	tests := []struct {
+		name    string
+		edges   [][2]int
+		bridges []Edge[int]
+		cuts    []int
+	}{
+		{
+			name:    "two triangles joined by a bridge",
+			edges:   [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 3}},
+			bridges: []Edge[int]{{2, 3}},
+			cuts:    []int{2, 3},
+		},
+		{
+			name:    "path",
+			edges:   [][2]int{{0, 1}, {1, 2}, {2, 3}},
+			bridges: []Edge[int]{{2, 3}, {1, 2}, {0, 1}},
+			cuts:    []int{1, 2},
+		},
+		{
+			name:    "star",
+			edges:   [][2]int{{0, 1}, {0, 2}, {0, 3}},
+			bridges: []Edge[int]{{0, 1}, {0, 2}, {0, 3}},
+			cuts:    []int{0},
+		},
+		{
+			name:    "parallel edges",
+			edges:   [][2]int{{0, 1}, {0, 1}, {1, 2}},
+			bridges: []Edge[int]{{1, 2}},
+			cuts:    []int{1},
+		},
+		{
+			name:    "cycle",
+			edges:   [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}},
+			bridges: nil,
+			cuts:    []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := undirected(tt.edges)
+			if got := Bridges(graph); !slices.Equal(got, tt.bridges) {
+				t.Errorf("Bridges() = %v, expected %v", got, tt.bridges)
+			}
+			if got := ArticulationPoints(graph); !slices.Equal(got, tt.cuts) {
+				t.Errorf("ArticulationPoints() = %v, expected %v", got, tt.cuts)
+			}
+		})
+	}
+}
+
+// components counts the connected components of graph, ignoring vertex skip and the edge between a and b.
+func components(graph map[int][]int, skip int, a, b int) int {
+	seen := map[int]bool{skip: true}
+	count := 0
+	for _, root := range adjmap.Vertices(graph) {
+		if seen[root] {
+			continue
+		}
+		count++
+		seen[root] = true
+		frontier := []int{root}
+		for len(frontier) > 0 {
+			u := frontier[len(frontier)-1]
+			frontier = frontier[:len(frontier)-1]
+			for _, v := range graph[u] {
+				if (u == a && v == b) || (u == b && v == a) || seen[v] {
+					continue
+				}
+				seen[v] = true
+				frontier = append(frontier, v)
+			}
+		}
+	}
+	return count
+}
+
+func TestBridgesAndArticulationPointsRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for round := range 20 {
+		var edges [][2]int
+		seen := make(map[[2]int]bool)
+		for range 35 {
+			u, v := rng.Intn(30), rng.Intn(30)
+			if u == v || seen[[2]int{u, v}] || seen[[2]int{v, u}] {
+				continue
+			}
+			seen[[2]int{u, v}] = true
+			edges = append(edges, [2]int{u, v})
+		}
+		graph := undirected(edges)
+		base := components(graph, -1, -1, -1)
+
+		var expectedCuts []int
+		for _, v := range adjmap.Vertices(graph) {
+			if components(graph, v, -1, -1) > base {
+				expectedCuts = append(expectedCuts, v)
+			}
+		}
+		if got := ArticulationPoints(graph); !slices.Equal(got, expectedCuts) {
+			t.Errorf("round %d: ArticulationPoints() = %v, expected %v", round, got, expectedCuts)
+		}
+
+		bridges := Bridges(graph)
+		for _, e := range edges {
+			isBridge := components(graph, -1, e[0], e[1]) > base
+			found := slices.Contains(bridges, Edge[int]{e[0], e[1]}) || slices.Contains(bridges, Edge[int]{e[1], e[0]})
+			if isBridge != found {
+				t.Errorf("round %d: edge %v is a bridge = %v, reported = %v", round, e, isBridge, found)
+			}
+		}
+	}
+}
+
+func TestArticulationPointsDeepPath(t *testing.T) {
+	// A recursive implementation would need one goroutine stack frame per vertex.
+	const n = 200000
+	edges := make([][2]int, n-1)
+	for i := range edges {
+		edges[i] = [2]int{i, i + 1}
+	}
+
+	graph := undirected(edges)
+	if cuts := ArticulationPoints(graph); len(cuts) != n-2 {
+		t.Errorf("Expected %d articulation points, got %d", n-2, len(cuts))
+	}
+	if bridges := Bridges(graph); len(bridges) != n-1 {
+		t.Errorf("Expected %d bridges, got %d", n-1, len(bridges))
+	}
+}
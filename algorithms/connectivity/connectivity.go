@@ -0,0 +1,8 @@
+// Package connectivity finds strongly connected components of directed graphs and bridges and
+// articulation points of undirected graphs.
+//
+// Graphs are plain adjacency maps: graph[u] lists the vertices v with an edge u -> v. Vertices that
+// only appear as edge targets are included. Every algorithm is an iterative depth-first search driven
+// by stack.Stack, so graphs with millions of vertices cannot overflow the goroutine stack. Searches
+// start from vertices in ascending order and follow edges in slice order, so results are deterministic.
+package connectivity
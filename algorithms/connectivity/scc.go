@@ -0,0 +1,73 @@
+package connectivity
+
+import (
+	"cmp"
+
+	"github.com/codeYann/go-collections/algorithms/internal/adjmap"
+	"github.com/codeYann/go-collections/stack"
+)
+
+// StronglyConnectedComponents returns the strongly connected components of a directed graph using
+// Tarjan's algorithm in O(V + E). Components are returned in reverse topological order of the
+// condensation: no component has an edge to a component listed after it. Within a component,
+// vertices are listed in the reverse of the order they were discovered.
+func StronglyConnectedComponents[V cmp.Ordered](graph map[V][]V) [][]V {
+	all := adjmap.Vertices(graph)
+	index := make(map[V]int, len(all))
+	low := make(map[V]int, len(all))
+	onStack := make(map[V]bool, len(all))
+	var components [][]V
+
+	// A vertex is pushed on each stack only when it is discovered, so neither holds more than len(all) entries.
+	calls := stack.NewStack[*adjmap.Frame[V]](uint(len(all)))
+	pending := stack.NewStack[V](uint(len(all)))
+
+	discover := func(v V) {
+		index[v] = len(index)
+		low[v] = index[v]
+		pending.Push(v)
+		onStack[v] = true
+		calls.Push(&adjmap.Frame[V]{Vertex: v})
+	}
+
+	for _, root := range all {
+		if _, ok := index[root]; ok {
+			continue
+		}
+		discover(root)
+
+		for !calls.IsEmpty() {
+			top, _ := calls.Peek()
+			edges := graph[top.Vertex]
+
+			if top.Next < len(edges) {
+				to := edges[top.Next]
+				top.Next++
+				if _, ok := index[to]; !ok {
+					discover(to)
+				} else if onStack[to] {
+					low[top.Vertex] = min(low[top.Vertex], index[to])
+				}
+				continue
+			}
+
+			calls.Pop()
+			if low[top.Vertex] == index[top.Vertex] {
+				var component []V
+				for {
+					v, _ := pending.Pop()
+					onStack[v] = false
+					component = append(component, v)
+					if v == top.Vertex {
+						break
+					}
+				}
+				components = append(components, component)
+			}
+			if parent, err := calls.Peek(); err == nil {
+				low[parent.Vertex] = min(low[parent.Vertex], low[top.Vertex])
+			}
+		}
+	}
+	return components
+}
@@ -0,0 +1,94 @@
+package connectivity
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/codeYann/go-collections/algorithms/internal/adjmap"
+)
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	graph := map[string][]string{
+		"a": {"b"},
+		"b": {"c", "e"},
+		"c": {"a", "d"},
+		"d": {"f"},
+		"e": {"f"},
+		"f": {"g"},
+		"g": {"e"},
+		"h": {"g"},
+	}
+
+	expected := [][]string{{"e", "g", "f"}, {"d"}, {"c", "b", "a"}, {"h"}}
+	if got := StronglyConnectedComponents(graph); !slices.EqualFunc(got, expected, slices.Equal) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+// reaches returns the set of vertices reachable from v.
+func reaches(graph map[int][]int, v int) map[int]bool {
+	seen := map[int]bool{v: true}
+	frontier := []int{v}
+	for len(frontier) > 0 {
+		u := frontier[len(frontier)-1]
+		frontier = frontier[:len(frontier)-1]
+		for _, w := range graph[u] {
+			if !seen[w] {
+				seen[w] = true
+				frontier = append(frontier, w)
+			}
+		}
+	}
+	return seen
+}
+
+func TestStronglyConnectedComponentsRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for round := range 20 {
+		graph := make(map[int][]int)
+		for range 60 {
+			u, v := rng.Intn(40), rng.Intn(40)
+			graph[u] = append(graph[u], v)
+		}
+
+		reach := make(map[int]map[int]bool)
+		for _, v := range adjmap.Vertices(graph) {
+			reach[v] = reaches(graph, v)
+		}
+
+		component := make(map[int]int)
+		for i, c := range StronglyConnectedComponents(graph) {
+			for _, v := range c {
+				component[v] = i
+			}
+		}
+		for _, u := range adjmap.Vertices(graph) {
+			for _, v := range adjmap.Vertices(graph) {
+				same := reach[u][v] && reach[v][u]
+				if (component[u] == component[v]) != same {
+					t.Fatalf("round %d: %d and %d mutually reachable = %v, same component = %v",
+						round, u, v, same, component[u] == component[v])
+				}
+				// Components come in reverse topological order.
+				if reach[u][v] && component[u] < component[v] {
+					t.Fatalf("round %d: component of %d listed before component of %d it reaches", round, u, v)
+				}
+			}
+		}
+	}
+}
+
+func TestStronglyConnectedComponentsDeepCycle(t *testing.T) {
+	// A recursive implementation would need one goroutine stack frame per vertex.
+	const n = 200000
+	graph := make(map[int][]int, n)
+	for i := range n {
+		graph[i] = []int{(i + 1) % n}
+	}
+
+	components := StronglyConnectedComponents(graph)
+	if len(components) != 1 || len(components[0]) != n {
+		t.Errorf("Expected a single component of %d vertices, got %d components", n, len(components))
+	}
+}
@@ -0,0 +1,36 @@
+// Package adjmap holds helpers shared by the algorithms that take a graph as a plain adjacency
+// map, where graph[u] lists the vertices v with an edge u -> v.
+package adjmap
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Vertices returns every vertex of the graph, including those that only appear as edge targets, in ascending order.
+func Vertices[V cmp.Ordered](graph map[V][]V) []V {
+	seen := make(map[V]bool, len(graph))
+	var all []V
+	add := func(v V) {
+		if !seen[v] {
+			seen[v] = true
+			all = append(all, v)
+		}
+	}
+
+	for u, edges := range graph {
+		add(u)
+		for _, v := range edges {
+			add(v)
+		}
+	}
+	slices.Sort(all)
+	return all
+}
+
+// Frame is a vertex on an iterative depth-first search stack together with the index of the next
+// edge of graph[Vertex] to explore.
+type Frame[V any] struct {
+	Vertex V
+	Next   int
+}
@@ -0,0 +1,26 @@
+package adjmap
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestVertices(t *testing.T) {
+	tests := []struct {
+		name     string
+		graph    map[string][]string
+		expected []string
+	}{
+		{"empty", map[string][]string{}, nil},
+		{"targets only", map[string][]string{"c": {"a", "b", "a"}}, []string{"a", "b", "c"}},
+		{"isolated", map[string][]string{"z": nil, "y": {"z"}}, []string{"y", "z"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Vertices(tt.graph); !slices.Equal(got, tt.expected) {
+				t.Errorf("Vertices() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
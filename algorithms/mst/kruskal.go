@@ -0,0 +1,33 @@
+package mst
+
+import (
+	"github.com/codeYann/go-collections/algorithms/sorting"
+	"github.com/codeYann/go-collections/unionfind"
+)
+
+// Kruskal returns the edges of a minimum spanning forest, in the order they were chosen, and their
+// total weight. It runs in O(E log E): the distinct weights are ordered with sorting.MergeSort and
+// edges of equal weight are considered in input order, so the result is deterministic.
+// Weights must not be NaN.
+func Kruskal[V comparable, W Weight](edges []Edge[V, W]) ([]Edge[V, W], W) {
+	byWeight := make(map[W][]int)
+	var weights []W
+	for i, e := range edges {
+		if _, ok := byWeight[e.Weight]; !ok {
+			weights = append(weights, e.Weight)
+		}
+		byWeight[e.Weight] = append(byWeight[e.Weight], i)
+	}
+	sorting.MergeSort(weights, 0, len(weights)-1)
+
+	dsu := unionfind.NewDSU[V]()
+	var tree []Edge[V, W]
+	for _, w := range weights {
+		for _, i := range byWeight[w] {
+			if dsu.Union(edges[i].From, edges[i].To) {
+				tree = append(tree, edges[i])
+			}
+		}
+	}
+	return tree, total(tree)
+}
@@ -0,0 +1,26 @@
+// Package mst computes minimum spanning trees of undirected weighted graphs given as edge lists.
+// When the graph is disconnected, both algorithms return a minimum spanning forest: one tree
+// per connected component.
+package mst
+
+// Weight is the set of numeric types usable as edge weights.
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Edge is an undirected edge between From and To.
+type Edge[V comparable, W Weight] struct {
+	From, To V
+	Weight   W
+}
+
+// total returns the sum of the weights of edges.
+func total[V comparable, W Weight](edges []Edge[V, W]) W {
+	var sum W
+	for _, e := range edges {
+		sum += e.Weight
+	}
+	return sum
+}
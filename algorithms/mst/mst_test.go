@@ -0,0 +1,100 @@
+package mst
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/codeYann/go-collections/unionfind"
+)
+
+type algorithm struct {
+	name string
+	run  func([]Edge[string, int]) ([]Edge[string, int], int)
+}
+
+var algorithms = []algorithm{
+	{"Kruskal", Kruskal[string, int]},
+	{"Prim", Prim[string, int]},
+}
+
+func TestMST(t *testing.T) {
+	tests := []struct {
+		name   string
+		edges  []Edge[string, int]
+		weight int
+		size   int
+	}{
+		{
+			name: "connected",
+			edges: []Edge[string, int]{
+				{"a", "b", 4}, {"a", "h", 8}, {"b", "c", 8}, {"b", "h", 11},
+				{"c", "d", 7}, {"c", "f", 4}, {"c", "i", 2}, {"d", "e", 9},
+				{"d", "f", 14}, {"e", "f", 10}, {"f", "g", 2}, {"g", "h", 1},
+				{"g", "i", 6}, {"h", "i", 7},
+			},
+			weight: 37,
+			size:   8,
+		},
+		{
+			name: "forest",
+			edges: []Edge[string, int]{
+				{"a", "b", 3}, {"b", "c", 1}, {"a", "c", 2},
+				{"x", "y", 5}, {"y", "y", -4},
+			},
+			weight: 8,
+			size:   3,
+		},
+		{
+			name: "negative weights",
+			edges: []Edge[string, int]{
+				{"a", "b", -1}, {"b", "c", -2}, {"a", "c", 0},
+			},
+			weight: -3,
+			size:   2,
+		},
+		{
+			name:   "empty",
+			edges:  nil,
+			weight: 0,
+			size:   0,
+		},
+	}
+
+	for _, alg := range algorithms {
+		for _, tt := range tests {
+			t.Run(alg.name+"/"+tt.name, func(t *testing.T) {
+				tree, weight := alg.run(tt.edges)
+				if weight != tt.weight {
+					t.Errorf("Expected total weight %d, got %d", tt.weight, weight)
+				}
+				if len(tree) != tt.size {
+					t.Errorf("Expected %d edges, got %d: %v", tt.size, len(tree), tree)
+				}
+
+				dsu := unionfind.NewDSU[string]()
+				for _, e := range tree {
+					if !dsu.Union(e.From, e.To) {
+						t.Errorf("Edge %v closes a cycle in %v", e, tree)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestKruskalAndPrimAgree(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for round := range 20 {
+		var edges []Edge[int, int]
+		for range 200 {
+			edges = append(edges, Edge[int, int]{rng.Intn(60), rng.Intn(60), rng.Intn(100)})
+		}
+
+		kruskal, kw := Kruskal(edges)
+		prim, pw := Prim(edges)
+		if kw != pw || len(kruskal) != len(prim) {
+			t.Errorf("round %d: Kruskal found %d edges of weight %d, Prim found %d of weight %d",
+				round, len(kruskal), kw, len(prim), pw)
+		}
+	}
+}
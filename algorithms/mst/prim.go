@@ -0,0 +1,67 @@
+package mst
+
+import (
+	"cmp"
+
+	"github.com/codeYann/go-collections/heap"
+)
+
+// Prim returns the edges of a minimum spanning forest, in the order they were chosen, and their
+// total weight. It grows one tree at a time from the vertices in order of first appearance in edges,
+// keeping the lightest edge to every fringe vertex in an indexed priority queue, in O(E log V).
+func Prim[V comparable, W Weight](edges []Edge[V, W]) ([]Edge[V, W], W) {
+	adj := make(map[V][]int)
+	var vertices []V
+	for i, e := range edges {
+		for _, v := range [2]V{e.From, e.To} {
+			if _, ok := adj[v]; !ok {
+				vertices = append(vertices, v)
+				adj[v] = nil
+			}
+		}
+		adj[e.From] = append(adj[e.From], i)
+		if e.To != e.From {
+			adj[e.To] = append(adj[e.To], i)
+		}
+	}
+
+	inTree := make(map[V]bool, len(vertices))
+	best := make(map[V]int) // index of the lightest edge connecting a fringe vertex to the tree
+	pq := heap.NewIndexedPriorityQueue[V](cmp.Compare[W])
+	var tree []Edge[V, W]
+
+	for _, root := range vertices {
+		if inTree[root] {
+			continue
+		}
+		pq.Insert(root, 0)
+
+		for !pq.IsEmpty() {
+			u, _, _ := pq.PopMin()
+			inTree[u] = true
+			if i, ok := best[u]; ok {
+				tree = append(tree, edges[i])
+			}
+
+			for _, i := range adj[u] {
+				v := edges[i].To
+				if v == u {
+					v = edges[i].From
+				}
+				if inTree[v] {
+					continue
+				}
+
+				w := edges[i].Weight
+				if current, ok := pq.Priority(v); !ok {
+					pq.Insert(v, w)
+					best[v] = i
+				} else if w < current {
+					pq.DecreaseKey(v, w)
+					best[v] = i
+				}
+			}
+		}
+	}
+	return tree, total(tree)
+}
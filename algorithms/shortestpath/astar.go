@@ -0,0 +1,60 @@
+package shortestpath
+
+import (
+	"cmp"
+
+	"github.com/codeYann/go-collections/heap"
+)
+
+// AStar searches for the shortest path from source to target, expanding vertices in order of
+// their distance from source plus heuristic's estimate of the remaining distance to target.
+// The heuristic must never overestimate for the result to be optimal; a vertex whose distance
+// improves after it was expanded is expanded again, so consistency is not required.
+// Edge weights must be non-negative; ErrNegativeWeight is returned otherwise.
+//
+// The search stops as soon as target is expanded. The returned Paths only holds the vertices
+// expanded up to that point, so use PathTo(target) and Distance(target) to read the result;
+// both report false if target is unreachable.
+func AStar[V comparable, W Weight](source, target V, adj Adjacency[V, W], heuristic func(v V) W) (*Paths[V, W], error) {
+	paths := newPaths[V, W](source)
+	best := map[V]W{source: paths.dist[source]}
+	parent := make(map[V]V)
+	delete(paths.dist, source)
+
+	pq := heap.NewIndexedPriorityQueue[V](cmp.Compare[W])
+	pq.Insert(source, heuristic(source))
+
+	for !pq.IsEmpty() {
+		u, _, _ := pq.PopMin()
+		d := best[u]
+		paths.dist[u] = d
+		if p, ok := parent[u]; ok {
+			paths.prev[u] = p
+		}
+		if u == target {
+			break
+		}
+
+		for v, w := range adj(u) {
+			if w < 0 {
+				return nil, ErrNegativeWeight
+			}
+
+			next := d + w
+			if current, ok := best[v]; ok && next >= current {
+				continue
+			}
+			best[v] = next
+			parent[v] = u
+
+			estimate := next + heuristic(v)
+			if pq.Contains(v) {
+				pq.DecreaseKey(v, estimate)
+			} else {
+				pq.Insert(v, estimate)
+			}
+		}
+	}
+
+	return paths, nil
+}
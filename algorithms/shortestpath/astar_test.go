@@ -0,0 +1,51 @@
+package shortestpath
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/codeYann/go-collections/graph"
+)
+
+func TestAStarUnreachableTarget(t *testing.T) {
+	g := graph.NewDirected[int, int]()
+	g.AddEdge(0, 1, 1)
+	g.AddVertex(2)
+
+	paths, err := AStar(0, 2, g.Neighbors, func(int) int { return 0 })
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, ok := paths.PathTo(2); ok {
+		t.Error("Expected no path to an unreachable target")
+	}
+}
+
+func TestAStarInconsistentHeuristic(t *testing.T) {
+	// The heuristic is admissible but not consistent: it makes b look worse than it is,
+	// so c is first expanded through the longer route and must be reopened.
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("s", "a", 1)
+	g.AddEdge("s", "b", 1)
+	g.AddEdge("a", "c", 3)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("c", "t", 3)
+
+	h := map[string]int{"s": 0, "a": 0, "b": 3, "c": 0, "t": 0}
+	paths, err := AStar("s", "t", g.Neighbors, func(v string) int { return h[v] })
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if d, ok := paths.Distance("t"); !ok || d != 5 {
+		t.Errorf("Expected distance 5, got %d, %v", d, ok)
+	}
+}
+
+func TestAStarRejectsNegativeWeights(t *testing.T) {
+	g := graph.NewDirected[int, int]()
+	g.AddEdge(0, 1, -1)
+
+	if _, err := AStar(0, 1, g.Neighbors, func(int) int { return 0 }); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("Expected ErrNegativeWeight, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package shortestpath
+
+import (
+	"fmt"
+	"slices"
+)
+
+// NegativeCycleError is returned by BellmanFord when a cycle of negative total weight
+// is reachable from the source, making shortest paths undefined.
+type NegativeCycleError[V comparable] struct {
+	// Cycle lists the vertices of one negative cycle in edge order; the last vertex has an edge back to the first.
+	Cycle []V
+}
+
+func (e *NegativeCycleError[V]) Error() string {
+	return fmt.Sprintf("negative cycle reachable from source: %v", e.Cycle)
+}
+
+// edge is a directed weighted edge collected by BellmanFord.
+type edge[V comparable, W Weight] struct {
+	from, to V
+	weight   W
+}
+
+// reachable collects, in breadth-first order, every vertex reachable from source and every edge leaving them.
+func reachable[V comparable, W Weight](source V, adj Adjacency[V, W]) ([]V, []edge[V, W]) {
+	vertices := []V{source}
+	var edges []edge[V, W]
+	seen := map[V]bool{source: true}
+
+	for i := 0; i < len(vertices); i++ {
+		u := vertices[i]
+		for v, w := range adj(u) {
+			edges = append(edges, edge[V, W]{from: u, to: v, weight: w})
+			if !seen[v] {
+				seen[v] = true
+				vertices = append(vertices, v)
+			}
+		}
+	}
+	return vertices, edges
+}
+
+// BellmanFord computes the shortest paths from source to every reachable vertex in O(V * E),
+// allowing negative edge weights. If a negative cycle is reachable from source, it returns a
+// *NegativeCycleError holding one such cycle.
+func BellmanFord[V comparable, W Weight](source V, adj Adjacency[V, W]) (*Paths[V, W], error) {
+	vertices, edges := reachable(source, adj)
+	paths := newPaths[V, W](source)
+
+	for round := 1; round < len(vertices); round++ {
+		changed := false
+		for _, e := range edges {
+			if paths.relax(e) {
+				changed = true
+			}
+		}
+		if !changed {
+			return paths, nil
+		}
+	}
+
+	for _, e := range edges {
+		if paths.relax(e) {
+			return nil, &NegativeCycleError[V]{Cycle: paths.cycleThrough(e.to, len(vertices))}
+		}
+	}
+	return paths, nil
+}
+
+// relax shortens the path to e.to through e.from if that is an improvement and reports whether it did.
+func (p *Paths[V, W]) relax(e edge[V, W]) bool {
+	d, ok := p.dist[e.from]
+	if !ok {
+		return false
+	}
+	if current, ok := p.dist[e.to]; ok && d+e.weight >= current {
+		return false
+	}
+	p.dist[e.to] = d + e.weight
+	p.prev[e.to] = e.from
+	return true
+}
+
+// cycleThrough follows predecessor links from v, which was relaxed after n-1 rounds, and returns
+// the negative cycle it leads into. Walking back n steps guarantees that the walk is on the cycle.
+func (p *Paths[V, W]) cycleThrough(v V, n int) []V {
+	for range n {
+		v = p.prev[v]
+	}
+
+	// The predecessor links walk the cycle backwards; reverse to report it in edge order.
+	cycle := []V{v}
+	for u := p.prev[v]; u != v; u = p.prev[u] {
+		cycle = append(cycle, u)
+	}
+	slices.Reverse(cycle)
+	return cycle
+}
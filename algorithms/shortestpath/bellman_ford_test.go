@@ -0,0 +1,92 @@
+package shortestpath
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/codeYann/go-collections/graph"
+)
+
+func TestBellmanFordNegativeWeights(t *testing.T) {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("s", "a", 4)
+	g.AddEdge("s", "b", 5)
+	g.AddEdge("a", "c", 3)
+	g.AddEdge("b", "a", -3)
+	g.AddEdge("c", "d", 2)
+	g.AddEdge("b", "d", 8)
+
+	paths, err := BellmanFord("s", g.Neighbors)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[string]int{"s": 0, "a": 2, "b": 5, "c": 5, "d": 7}
+	for v, want := range expected {
+		if got, ok := paths.Distance(v); !ok || got != want {
+			t.Errorf("Distance(%s) = %d, %v; expected %d", v, got, ok, want)
+		}
+	}
+	if path, _ := paths.PathTo("d"); !slices.Equal(path, []string{"s", "b", "a", "c", "d"}) {
+		t.Errorf("PathTo(d) = %v, expected [s b a c d]", path)
+	}
+}
+
+func TestBellmanFordNegativeCycle(t *testing.T) {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("s", "a", 1)
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", -3)
+	g.AddEdge("c", "a", 1)
+	g.AddEdge("c", "t", 1)
+
+	_, err := BellmanFord("s", g.Neighbors)
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Expected NegativeCycleError, got %v", err)
+	}
+
+	cycle := cycleErr.Cycle
+	if len(cycle) != 3 {
+		t.Fatalf("Expected a cycle of 3 vertices, got %v", cycle)
+	}
+	total := 0
+	for i, v := range cycle {
+		w, ok := g.Weight(v, cycle[(i+1)%len(cycle)])
+		if !ok {
+			t.Fatalf("Cycle %v uses a missing edge %s -> %s", cycle, v, cycle[(i+1)%len(cycle)])
+		}
+		total += w
+	}
+	if total >= 0 {
+		t.Errorf("Expected a negative cycle, got total weight %d", total)
+	}
+}
+
+func TestBellmanFordNegativeSelfLoop(t *testing.T) {
+	g := graph.NewDirected[int, int]()
+	g.AddEdge(0, 1, 2)
+	g.AddEdge(1, 1, -1)
+
+	_, err := BellmanFord(0, g.Neighbors)
+	var cycleErr *NegativeCycleError[int]
+	if !errors.As(err, &cycleErr) || !slices.Equal(cycleErr.Cycle, []int{1}) {
+		t.Errorf("Expected self-loop cycle [1], got %v", err)
+	}
+}
+
+func TestBellmanFordIgnoresUnreachableCycle(t *testing.T) {
+	g := graph.NewDirected[int, int]()
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(2, 3, -5)
+	g.AddEdge(3, 2, 1)
+
+	paths, err := BellmanFord(0, g.Neighbors)
+	if err != nil {
+		t.Fatalf("Expected unreachable negative cycle to be ignored, got %v", err)
+	}
+	if _, ok := paths.Distance(2); ok {
+		t.Error("Expected unreachable vertex to have no distance")
+	}
+}
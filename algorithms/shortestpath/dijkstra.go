@@ -0,0 +1,46 @@
+package shortestpath
+
+import (
+	"cmp"
+
+	"github.com/codeYann/go-collections/heap"
+)
+
+// Dijkstra computes the shortest paths from source to every reachable vertex in O((V + E) log V).
+// All edge weights must be non-negative; ErrNegativeWeight is returned otherwise.
+func Dijkstra[V comparable, W Weight](source V, adj Adjacency[V, W]) (*Paths[V, W], error) {
+	paths := newPaths[V, W](source)
+	settled := make(map[V]bool)
+
+	pq := heap.NewIndexedPriorityQueue[V](cmp.Compare[W])
+	pq.Insert(source, paths.dist[source])
+
+	for !pq.IsEmpty() {
+		u, d, _ := pq.PopMin()
+		settled[u] = true
+
+		for v, w := range adj(u) {
+			if w < 0 {
+				return nil, ErrNegativeWeight
+			}
+			if settled[v] {
+				continue
+			}
+
+			next := d + w
+			if current, ok := paths.dist[v]; ok && next >= current {
+				continue
+			}
+			paths.dist[v] = next
+			paths.prev[v] = u
+
+			if pq.Contains(v) {
+				pq.DecreaseKey(v, next)
+			} else {
+				pq.Insert(v, next)
+			}
+		}
+	}
+
+	return paths, nil
+}
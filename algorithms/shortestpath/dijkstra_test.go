@@ -0,0 +1,47 @@
+package shortestpath
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/codeYann/go-collections/graph"
+)
+
+func TestDijkstraUndirected(t *testing.T) {
+	g := graph.NewUndirected[int, float64]()
+	g.AddEdge(1, 2, 7)
+	g.AddEdge(1, 3, 9)
+	g.AddEdge(1, 6, 14)
+	g.AddEdge(2, 3, 10)
+	g.AddEdge(2, 4, 15)
+	g.AddEdge(3, 4, 11)
+	g.AddEdge(3, 6, 2)
+	g.AddEdge(4, 5, 6)
+	g.AddEdge(5, 6, 9)
+
+	paths, err := Dijkstra(1, g.Neighbors)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := map[int]float64{1: 0, 2: 7, 3: 9, 4: 20, 5: 20, 6: 11}
+	for v, want := range expected {
+		if got, ok := paths.Distance(v); !ok || got != want {
+			t.Errorf("Distance(%d) = %v, %v; expected %v", v, got, ok, want)
+		}
+	}
+	if path, _ := paths.PathTo(5); !slices.Equal(path, []int{1, 3, 6, 5}) {
+		t.Errorf("PathTo(5) = %v, expected [1 3 6 5]", path)
+	}
+}
+
+func TestDijkstraRejectsNegativeWeights(t *testing.T) {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", -1)
+
+	if _, err := Dijkstra("a", g.Neighbors); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("Expected ErrNegativeWeight, got %v", err)
+	}
+}
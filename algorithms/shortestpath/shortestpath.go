@@ -0,0 +1,67 @@
+// Package shortestpath implements single-source shortest-path algorithms over a
+// minimal adjacency callback, so they work with graph.Graph.Neighbors as well as
+// implicit graphs such as grids that are never materialised.
+package shortestpath
+
+import (
+	"errors"
+	"iter"
+	"slices"
+)
+
+// Weight is the set of numeric types usable as edge weights and distances.
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Adjacency returns an iterator over the neighbors of v and the weights of the edges reaching them.
+type Adjacency[V comparable, W Weight] func(v V) iter.Seq2[V, W]
+
+// ErrNegativeWeight is returned by Dijkstra and AStar when they meet an edge with a negative weight.
+var ErrNegativeWeight = errors.New("negative edge weight")
+
+// Paths holds the result of a shortest-path search from a single source: the distance
+// to every vertex the search settled and the predecessor links to rebuild the paths.
+type Paths[V comparable, W Weight] struct {
+	source V
+	dist   map[V]W
+	prev   map[V]V
+}
+
+func newPaths[V comparable, W Weight](source V) *Paths[V, W] {
+	var zero W
+	return &Paths[V, W]{
+		source: source,
+		dist:   map[V]W{source: zero},
+		prev:   make(map[V]V),
+	}
+}
+
+// Source returns the vertex the search started from.
+func (p *Paths[V, W]) Source() V {
+	return p.source
+}
+
+// Distance returns the length of the shortest path from the source to v, or false if v was not reached.
+func (p *Paths[V, W]) Distance(v V) (W, bool) {
+	d, ok := p.dist[v]
+	return d, ok
+}
+
+// PathTo returns the vertices of the shortest path from the source to v, both included,
+// or false if v was not reached.
+func (p *Paths[V, W]) PathTo(v V) ([]V, bool) {
+	if _, ok := p.dist[v]; !ok {
+		return nil, false
+	}
+
+	path := []V{v}
+	for v != p.source {
+		v = p.prev[v]
+		path = append(path, v)
+	}
+	slices.Reverse(path)
+	return path, true
+}
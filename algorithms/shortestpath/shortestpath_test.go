@@ -0,0 +1,170 @@
+package shortestpath
+
+import (
+	"fmt"
+	"iter"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/codeYann/go-collections/graph"
+)
+
+type cell struct {
+	row, col int
+}
+
+// grid is a generated rows x cols grid graph with 4-neighbour moves. The cost of entering
+// a cell is its weight, which is at least 1 so the Manhattan distance is an admissible heuristic.
+type grid struct {
+	rows, cols int
+	weights    []int
+}
+
+func newGrid(rows, cols int, seed int64) *grid {
+	rng := rand.New(rand.NewSource(seed))
+	g := &grid{rows: rows, cols: cols, weights: make([]int, rows*cols)}
+	for i := range g.weights {
+		g.weights[i] = 1 + rng.Intn(9)
+	}
+	return g
+}
+
+func (g *grid) neighbors(c cell) iter.Seq2[cell, int] {
+	return func(yield func(cell, int) bool) {
+		for _, d := range [4]cell{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+			n := cell{c.row + d.row, c.col + d.col}
+			if n.row < 0 || n.row >= g.rows || n.col < 0 || n.col >= g.cols {
+				continue
+			}
+			if !yield(n, g.weights[n.row*g.cols+n.col]) {
+				return
+			}
+		}
+	}
+}
+
+func manhattan(target cell) func(cell) int {
+	return func(c cell) int {
+		return abs(c.row-target.row) + abs(c.col-target.col)
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func TestPathsOnGraph(t *testing.T) {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("a", "b", 4)
+	g.AddEdge("a", "c", 1)
+	g.AddEdge("c", "b", 2)
+	g.AddEdge("b", "d", 5)
+	g.AddVertex("e")
+
+	paths, err := Dijkstra("a", g.Neighbors)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if paths.Source() != "a" {
+		t.Errorf("Expected source a, got %s", paths.Source())
+	}
+	if d, ok := paths.Distance("d"); !ok || d != 8 {
+		t.Errorf("Expected distance 8 to d, got %d, %v", d, ok)
+	}
+	if path, ok := paths.PathTo("d"); !ok || !slices.Equal(path, []string{"a", "c", "b", "d"}) {
+		t.Errorf("Expected path [a c b d], got %v, %v", path, ok)
+	}
+	if path, ok := paths.PathTo("a"); !ok || !slices.Equal(path, []string{"a"}) {
+		t.Errorf("Expected path [a] to the source, got %v, %v", path, ok)
+	}
+	if _, ok := paths.Distance("e"); ok {
+		t.Error("Expected unreachable vertex to have no distance")
+	}
+	if _, ok := paths.PathTo("e"); ok {
+		t.Error("Expected unreachable vertex to have no path")
+	}
+}
+
+func TestAlgorithmsAgreeOnGrids(t *testing.T) {
+	for seed := int64(0); seed < 5; seed++ {
+		g := newGrid(15, 20, seed)
+		source, target := cell{0, 0}, cell{14, 19}
+
+		dijkstra, err := Dijkstra(source, g.neighbors)
+		if err != nil {
+			t.Fatalf("Dijkstra: %v", err)
+		}
+		bellmanFord, err := BellmanFord(source, g.neighbors)
+		if err != nil {
+			t.Fatalf("BellmanFord: %v", err)
+		}
+		astar, err := AStar(source, target, g.neighbors, manhattan(target))
+		if err != nil {
+			t.Fatalf("AStar: %v", err)
+		}
+
+		for r := range g.rows {
+			for c := range g.cols {
+				want, _ := dijkstra.Distance(cell{r, c})
+				if got, ok := bellmanFord.Distance(cell{r, c}); !ok || got != want {
+					t.Fatalf("seed %d: BellmanFord distance to %v = %d, Dijkstra = %d", seed, cell{r, c}, got, want)
+				}
+			}
+		}
+
+		want, _ := dijkstra.Distance(target)
+		if got, ok := astar.Distance(target); !ok || got != want {
+			t.Errorf("seed %d: AStar distance = %d, Dijkstra = %d", seed, got, want)
+		}
+
+		// Every reported path must add up to the reported distance.
+		for _, paths := range []*Paths[cell, int]{dijkstra, bellmanFord, astar} {
+			path, ok := paths.PathTo(target)
+			if !ok || path[0] != source || path[len(path)-1] != target {
+				t.Fatalf("seed %d: invalid path %v", seed, path)
+			}
+			total := 0
+			for _, c := range path[1:] {
+				total += g.weights[c.row*g.cols+c.col]
+			}
+			if total != want {
+				t.Errorf("seed %d: path weight %d, expected %d", seed, total, want)
+			}
+		}
+	}
+}
+
+func benchmarkGrid(b *testing.B, run func(g *grid, source, target cell)) {
+	for _, size := range []int{32, 128} {
+		g := newGrid(size, size, 1)
+		source, target := cell{0, 0}, cell{size - 1, size - 1}
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			for b.Loop() {
+				run(g, source, target)
+			}
+		})
+	}
+}
+
+func BenchmarkDijkstraGrid(b *testing.B) {
+	benchmarkGrid(b, func(g *grid, source, _ cell) {
+		Dijkstra(source, g.neighbors)
+	})
+}
+
+func BenchmarkBellmanFordGrid(b *testing.B) {
+	benchmarkGrid(b, func(g *grid, source, _ cell) {
+		BellmanFord(source, g.neighbors)
+	})
+}
+
+func BenchmarkAStarGrid(b *testing.B) {
+	benchmarkGrid(b, func(g *grid, source, target cell) {
+		AStar(source, target, g.neighbors, manhattan(target))
+	})
+}
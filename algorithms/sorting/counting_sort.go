@@ -0,0 +1,95 @@
+package sorting
+
+// maxCountingRange is the key range up to which the counting sorts always allocate one count per
+// possible key. Wider ranges are still counted when the slice is at least as long as the range.
+const maxCountingRange = 1 << 16
+
+// keyRange returns the smallest and largest unsigned key of arr, which must not be empty.
+func keyRange[T any](arr []T, key func(T) uint64) (uint64, uint64) {
+	lo, hi := key(arr[0]), key(arr[0])
+	for _, v := range arr[1:] {
+		k := key(v)
+		lo, hi = min(lo, k), max(hi, k)
+	}
+	return lo, hi
+}
+
+// fitsCounting reports whether the keys from lo to hi are few enough to be counted individually for n elements.
+func fitsCounting(lo, hi uint64, n int) bool {
+	return hi-lo < uint64(max(n, maxCountingRange))
+}
+
+// CountingSort sorts a slice of integers by counting the occurrences of every value between the
+// smallest and the largest, in O(n + k) time and O(k) space for a range of k values. It is meant
+// for values drawn from a small range: if the range is wider than max(len(arr), 65536) values,
+// it falls back to RadixSort rather than allocating the counts.
+func CountingSort[T Integer](arr []T) {
+	if len(arr) < 2 {
+		return
+	}
+
+	key, _ := integerKey[T]()
+	lo, hi := keyRange(arr, key)
+	if !fitsCounting(lo, hi, len(arr)) {
+		RadixSort(arr)
+		return
+	}
+
+	minimum := arr[0]
+	for _, v := range arr {
+		minimum = min(minimum, v)
+	}
+
+	counts := make([]int, hi-lo+1)
+	for _, v := range arr {
+		counts[key(v)-lo]++
+	}
+
+	// Equal integers are indistinguishable, so the slice is rewritten from the counts.
+	// minimum + k wraps around like the original values did, so it is exact even for signed types.
+	i := 0
+	for k, c := range counts {
+		for range c {
+			arr[i] = minimum + T(k)
+			i++
+		}
+	}
+}
+
+// CountingSortFunc sorts a slice by the integer key that key extracts from each element, counting
+// the occurrences of every key between the smallest and the largest. It runs in O(n + k) time and
+// uses O(n + k) space for a range of k keys. The sort is stable: elements with equal keys keep
+// their original order. Like CountingSort, it falls back to RadixSortFunc for wide key ranges.
+func CountingSortFunc[T any, K Integer](arr []T, key func(T) K) {
+	if len(arr) < 2 {
+		return
+	}
+
+	toUnsigned, width := integerKey[K]()
+	keys := make([]uint64, len(arr))
+	for i, v := range arr {
+		keys[i] = toUnsigned(key(v))
+	}
+	lo, hi := keyRange(keys, func(k uint64) uint64 { return k })
+	if !fitsCounting(lo, hi, len(arr)) {
+		lsdRadixSort(arr, func(v T) uint64 { return toUnsigned(key(v)) }, width)
+		return
+	}
+
+	starts := make([]int, hi-lo+1)
+	for _, k := range keys {
+		starts[k-lo]++
+	}
+	offset := 0
+	for i, c := range starts {
+		starts[i] = offset
+		offset += c
+	}
+
+	sorted := make([]T, len(arr))
+	for i, v := range arr {
+		sorted[starts[keys[i]-lo]] = v
+		starts[keys[i]-lo]++
+	}
+	copy(arr, sorted)
+}
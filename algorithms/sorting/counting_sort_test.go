@@ -0,0 +1,34 @@
+package sorting
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestCountingSort(t *testing.T) {
+	checkIntegerSort(t, "small range", CountingSort[int], []int{3, -2, 3, 0, 1, -2, 5, 4, 4, 0})
+	checkIntegerSort(t, "int8 full range", CountingSort[int8], []int8{127, -128, 0, -1, 1, 127, -128})
+	checkIntegerSort(t, "uint8", CountingSort[uint8], []uint8{200, 3, 255, 0, 3})
+	// The range is far too wide to count, so this exercises the radix sort fallback.
+	checkIntegerSort(t, "wide range", CountingSort[int64], []int64{math.MaxInt64, math.MinInt64, 0, 12, -12})
+	checkIntegerSort(t, "empty", CountingSort[int], nil)
+}
+
+func TestCountingSortFuncStable(t *testing.T) {
+	type exam struct {
+		student string
+		grade   uint8
+	}
+	exams := []exam{
+		{"ana", 7}, {"bea", 9}, {"caio", 7}, {"duda", 10}, {"enzo", 9}, {"fabi", 7}, {"gil", 0},
+	}
+
+	CountingSortFunc(exams, func(e exam) uint8 { return e.grade })
+	expected := []exam{
+		{"gil", 0}, {"ana", 7}, {"caio", 7}, {"fabi", 7}, {"bea", 9}, {"enzo", 9}, {"duda", 10},
+	}
+	if !slices.Equal(exams, expected) {
+		t.Errorf("Expected %v, got %v", expected, exams)
+	}
+}
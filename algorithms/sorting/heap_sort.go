@@ -0,0 +1,41 @@
+package sorting
+
+import "cmp"
+
+// siftDown moves arr[root] down the max-heap arr until neither child is greater than it.
+func siftDown[T any](arr []T, root int, cmp func(a, b T) int) {
+	for {
+		child := 2*root + 1
+		if child >= len(arr) {
+			return
+		}
+		if child+1 < len(arr) && cmp(arr[child], arr[child+1]) < 0 {
+			child++
+		}
+		if cmp(arr[root], arr[child]) >= 0 {
+			return
+		}
+		arr[root], arr[child] = arr[child], arr[root]
+		root = child
+	}
+}
+
+// HeapSortFunc sorts a slice in place using the heapsort algorithm, ordering elements by the comparator cmp,
+// which returns a negative value if a < b, zero if a == b and a positive value if a > b.
+// It runs in O(n log n) in the worst case and uses O(1) extra space. The sort is not stable.
+func HeapSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	for i := len(arr)/2 - 1; i >= 0; i-- {
+		siftDown(arr, i, cmp)
+	}
+	for end := len(arr) - 1; end > 0; end-- {
+		arr[0], arr[end] = arr[end], arr[0]
+		siftDown(arr[:end], 0, cmp)
+	}
+}
+
+// HeapSort sorts a slice in place using the heapsort algorithm.
+// It runs in O(n log n) in the worst case and uses O(1) extra space. The sort is not stable.
+// Like slices.Sort, it orders floating-point NaNs before all other values.
+func HeapSort[T cmp.Ordered](arr []T) {
+	HeapSortFunc(arr, cmp.Compare[T])
+}
@@ -0,0 +1,55 @@
+package sorting
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestHeapSort(t *testing.T) {
+	tests := []struct {
+		name     string
+		arr      []int
+		expected []int
+	}{
+		{
+			name:     "random array",
+			arr:      []int{3, 1, 4, 1, 5, 9, 2, 6},
+			expected: []int{1, 1, 2, 3, 4, 5, 6, 9},
+		},
+		{
+			name:     "reverse sorted array",
+			arr:      []int{5, 4, 3, 2, 1},
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "empty array",
+			arr:      []int{},
+			expected: []int{},
+		},
+		{
+			name:     "single element",
+			arr:      []int{42},
+			expected: []int{42},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arr := slices.Clone(tt.arr)
+			HeapSort(arr)
+
+			if !slices.Equal(arr, tt.expected) {
+				t.Errorf("HeapSort(%v) = %v, expected %v", tt.arr, arr, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHeapSortFuncDescending(t *testing.T) {
+	arr := []string{"pear", "apple", "fig", "kiwi"}
+	HeapSortFunc(arr, func(a, b string) int { return len(b) - len(a) })
+
+	if len(arr[0]) != 5 || len(arr[3]) != 3 {
+		t.Errorf("Expected strings by descending length, got %v", arr)
+	}
+}
@@ -19,3 +19,18 @@ func InsertionSort[T cmp.Ordered](arr []T) {
 		arr[i+1] = key
 	}
 }
+
+// InsertionSortFunc sorts a slice in place using the insertion sort algorithm, ordering elements
+// by the comparator cmp, which returns a negative value if a < b, zero if a == b and a positive
+// value if a > b. The sort is stable: elements that compare equal keep their original order.
+func InsertionSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	for j := 1; j < len(arr); j++ {
+		key := arr[j]
+		i := j - 1
+		for i >= 0 && cmp(arr[i], key) > 0 {
+			arr[i+1] = arr[i]
+			i = i - 1
+		}
+		arr[i+1] = key
+	}
+}
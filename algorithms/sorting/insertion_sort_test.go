@@ -125,3 +125,42 @@ func TestInsertionSortFloat64(t *testing.T) {
 		t.Errorf("InsertionSort(%v) = %v, expected %v", []float64{3.14, 2.71, 1.41, 4.67}, arr, expected)
 	}
 }
+
+func TestInsertionSortFuncStable(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmp      func(a, b employee) int
+		expected []string
+	}{
+		{
+			name:     "ascending age",
+			cmp:      byAge,
+			expected: []string{"bob", "frank", "alice", "erin", "heidi", "carol", "dave", "grace"},
+		},
+		{
+			name:     "descending age",
+			cmp:      func(a, b employee) int { return byAge(b, a) },
+			expected: []string{"carol", "dave", "grace", "alice", "erin", "heidi", "bob", "frank"},
+		},
+		{
+			name:     "by name length, then stable",
+			cmp:      func(a, b employee) int { return len(a.name) - len(b.name) },
+			expected: []string{"bob", "dave", "erin", "carol", "alice", "frank", "grace", "heidi"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arr := slices.Clone(employees)
+			InsertionSortFunc(arr, tt.cmp)
+
+			names := make([]string, len(arr))
+			for i, e := range arr {
+				names[i] = e.name
+			}
+			if !slices.Equal(names, tt.expected) {
+				t.Errorf("InsertionSortFunc() = %v, expected %v", names, tt.expected)
+			}
+		})
+	}
+}
@@ -4,50 +4,138 @@ import (
 	"cmp"
 )
 
-// merge merges two sorted subarrays arr[start..mid] and arr[mid+1..end] into a single sorted subarray arr[start..end]
-func merge[T cmp.Ordered](arr []T, start, mid, end int) {
-	leftLen := mid - start + 1
-	rightLen := end - mid
+// insertionSortThreshold is the run length at or below which merge sort switches to insertion sort.
+const insertionSortThreshold = 12
 
-	left := make([]T, leftLen)
-	right := make([]T, rightLen)
+// merge merges the two sorted runs arr[:mid] and arr[mid:] into a single sorted run.
+// The left run is first copied into buf, which must hold at least mid elements; taking from
+// the left run on ties keeps the merge stable.
+func merge[T cmp.Ordered](arr []T, mid int, buf []T) {
+	left := buf[:mid]
+	copy(left, arr[:mid])
 
-	copy(left, arr[start:mid+1])
-	copy(right, arr[mid+1:end+1])
+	leftIdx, rightIdx, mergeIdx := 0, mid, 0
 
-	leftIdx, rightIdx, mergeIdx := 0, 0, start
-
-	for leftIdx < leftLen && rightIdx < rightLen {
-		if left[leftIdx] <= right[rightIdx] {
+	for leftIdx < len(left) && rightIdx < len(arr) {
+		if left[leftIdx] <= arr[rightIdx] {
 			arr[mergeIdx] = left[leftIdx]
 			leftIdx++
 		} else {
-			arr[mergeIdx] = right[rightIdx]
+			arr[mergeIdx] = arr[rightIdx]
 			rightIdx++
 		}
 		mergeIdx++
 	}
 
-	for leftIdx < leftLen {
-		arr[mergeIdx] = left[leftIdx]
-		leftIdx++
-		mergeIdx++
+	// Whatever is left of the right run is already in place.
+	copy(arr[mergeIdx:], left[leftIdx:])
+}
+
+// mergeSort sorts arr top-down, using buf, which must hold at least (len(arr)+1)/2 elements, as scratch space.
+func mergeSort[T cmp.Ordered](arr []T, buf []T) {
+	if len(arr) <= insertionSortThreshold {
+		InsertionSort(arr)
+		return
 	}
-	for rightIdx < rightLen {
-		arr[mergeIdx] = right[rightIdx]
-		rightIdx++
-		mergeIdx++
+
+	mid := (len(arr) + 1) / 2
+	mergeSort(arr[:mid], buf)
+	mergeSort(arr[mid:], buf)
+	if arr[mid-1] <= arr[mid] {
+		return
 	}
+	merge(arr, mid, buf)
 }
 
 // MergeSort sorts a slice using the merge sort algorithm.
 // start is the starting index and end is the ending index (inclusive).
+// The sort is stable: elements that compare equal keep their original order.
+// It allocates a single scratch buffer of half the range and sorts short runs with insertion sort.
 func MergeSort[T cmp.Ordered](arr []T, start, end int) {
 	if start < end {
-		mid := (start + end) / 2
+		n := end - start + 1
+		mergeSort(arr[start:end+1], make([]T, (n+1)/2))
+	}
+}
+
+// MergeSortBottomUp sorts a slice using an iterative, bottom-up merge sort: runs of a few elements
+// are sorted with insertion sort, then merged pairwise in passes of doubling width.
+// The sort is stable and allocates a single scratch buffer.
+func MergeSortBottomUp[T cmp.Ordered](arr []T) {
+	MergeSortBuffer(arr, nil)
+}
+
+// MergeSortBuffer sorts a slice like MergeSortBottomUp, using buf as scratch space so that repeated
+// sorts do not allocate. If buf is shorter than arr, a buffer of the right size is allocated instead.
+func MergeSortBuffer[T cmp.Ordered](arr, buf []T) {
+	n := len(arr)
+	if n <= 1 {
+		return
+	}
+	if len(buf) < n {
+		buf = make([]T, n)
+	}
+
+	for lo := 0; lo < n; lo += insertionSortThreshold {
+		InsertionSort(arr[lo:min(lo+insertionSortThreshold, n)])
+	}
+
+	for width := insertionSortThreshold; width < n; width *= 2 {
+		for lo := 0; lo+width < n; lo += 2 * width {
+			run := arr[lo:min(lo+2*width, n)]
+			if run[width-1] > run[width] {
+				merge(run, width, buf)
+			}
+		}
+	}
+}
 
-		MergeSort(arr, start, mid)
-		MergeSort(arr, mid+1, end)
-		merge(arr, start, mid, end)
+// mergeFunc merges the two sorted runs arr[:mid] and arr[mid:] into a single sorted run, ordering
+// elements by cmp. The left run is first copied into buf, which must hold at least mid elements;
+// taking from the left run on ties keeps the merge stable.
+func mergeFunc[T any](arr []T, mid int, buf []T, cmp func(a, b T) int) {
+	left := buf[:mid]
+	copy(left, arr[:mid])
+
+	leftIdx, rightIdx, mergeIdx := 0, mid, 0
+
+	for leftIdx < len(left) && rightIdx < len(arr) {
+		if cmp(left[leftIdx], arr[rightIdx]) <= 0 {
+			arr[mergeIdx] = left[leftIdx]
+			leftIdx++
+		} else {
+			arr[mergeIdx] = arr[rightIdx]
+			rightIdx++
+		}
+		mergeIdx++
+	}
+
+	copy(arr[mergeIdx:], left[leftIdx:])
+}
+
+// mergeSortFunc sorts arr top-down by cmp, using buf, which must hold at least (len(arr)+1)/2 elements, as scratch space.
+func mergeSortFunc[T any](arr []T, buf []T, cmp func(a, b T) int) {
+	if len(arr) <= insertionSortThreshold {
+		InsertionSortFunc(arr, cmp)
+		return
+	}
+
+	mid := (len(arr) + 1) / 2
+	mergeSortFunc(arr[:mid], buf, cmp)
+	mergeSortFunc(arr[mid:], buf, cmp)
+	if cmp(arr[mid-1], arr[mid]) <= 0 {
+		return
+	}
+	mergeFunc(arr, mid, buf, cmp)
+}
+
+// MergeSortFunc sorts a slice using the merge sort algorithm, ordering elements by the comparator cmp,
+// which returns a negative value if a < b, zero if a == b and a positive value if a > b.
+// start is the starting index and end is the ending index (inclusive).
+// Like MergeSort, the sort is stable: elements that compare equal keep their original order.
+func MergeSortFunc[T any](arr []T, start, end int, cmp func(a, b T) int) {
+	if start < end {
+		n := end - start + 1
+		mergeSortFunc(arr[start:end+1], make([]T, (n+1)/2), cmp)
 	}
 }
@@ -1,7 +1,9 @@
 package sorting
 
 import (
+	"math/rand"
 	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -106,3 +108,181 @@ func TestMergeSortString(t *testing.T) {
 		})
 	}
 }
+
+type employee struct {
+	name string
+	age  int
+}
+
+func byAge(a, b employee) int {
+	return a.age - b.age
+}
+
+// employees has several records with equal ages, listed in alphabetical order within each age.
+var employees = []employee{
+	{"carol", 35}, {"alice", 30}, {"dave", 35}, {"bob", 25},
+	{"erin", 30}, {"frank", 25}, {"grace", 35}, {"heidi", 30},
+}
+
+func TestMergeSortFuncStable(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmp      func(a, b employee) int
+		expected []string
+	}{
+		{
+			name:     "ascending age",
+			cmp:      byAge,
+			expected: []string{"bob", "frank", "alice", "erin", "heidi", "carol", "dave", "grace"},
+		},
+		{
+			name:     "descending age",
+			cmp:      func(a, b employee) int { return byAge(b, a) },
+			expected: []string{"carol", "dave", "grace", "alice", "erin", "heidi", "bob", "frank"},
+		},
+		{
+			name:     "all equal",
+			cmp:      func(a, b employee) int { return 0 },
+			expected: []string{"carol", "alice", "dave", "bob", "erin", "frank", "grace", "heidi"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arr := slices.Clone(employees)
+			MergeSortFunc(arr, 0, len(arr)-1, tt.cmp)
+
+			names := make([]string, len(arr))
+			for i, e := range arr {
+				names[i] = e.name
+			}
+			if !slices.Equal(names, tt.expected) {
+				t.Errorf("got %v, expected %v", names, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMergeSortFuncMatchesMergeSort(t *testing.T) {
+	input := []int{9, -3, 5, 0, 5, 12, -7, 1, 1, 8}
+
+	expected := slices.Clone(input)
+	MergeSort(expected, 0, len(expected)-1)
+
+	arr := slices.Clone(input)
+	MergeSortFunc(arr, 0, len(arr)-1, func(a, b int) int { return a - b })
+	if !slices.Equal(arr, expected) {
+		t.Errorf("got %v, expected %v", arr, expected)
+	}
+
+	empty := []int{}
+	MergeSortFunc(empty, 0, -1, func(a, b int) int { return a - b })
+}
+
+func TestMergeSortVariantsRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	sorts := []struct {
+		name string
+		sort func([]int)
+	}{
+		{"MergeSort", func(arr []int) { MergeSort(arr, 0, len(arr)-1) }},
+		{"MergeSortBottomUp", MergeSortBottomUp[int]},
+		{"MergeSortBuffer", func(arr []int) { MergeSortBuffer(arr, make([]int, len(arr))) }},
+		{"MergeSortBuffer short buffer", func(arr []int) { MergeSortBuffer(arr, make([]int, 1)) }},
+	}
+
+	for _, s := range sorts {
+		for _, n := range []int{0, 1, 2, 11, 12, 13, 24, 25, 100, 1000, 4097} {
+			arr := make([]int, n)
+			for i := range arr {
+				arr[i] = rng.Intn(n/2 + 1)
+			}
+			expected := slices.Clone(arr)
+			slices.Sort(expected)
+
+			s.sort(arr)
+			if !slices.Equal(arr, expected) {
+				t.Errorf("%s: wrong result for %d elements", s.name, n)
+			}
+		}
+	}
+}
+
+func TestMergeSortAllocations(t *testing.T) {
+	arr := make([]int, 10000)
+	buf := make([]int, len(arr))
+	shuffle := func() {
+		for i := range arr {
+			arr[i] = (i * 7919) % len(arr)
+		}
+	}
+
+	if allocs := testing.AllocsPerRun(10, func() { shuffle(); MergeSortBuffer(arr, buf) }); allocs != 0 {
+		t.Errorf("Expected MergeSortBuffer not to allocate, got %v allocations", allocs)
+	}
+	if allocs := testing.AllocsPerRun(10, func() { shuffle(); MergeSort(arr, 0, len(arr)-1) }); allocs != 1 {
+		t.Errorf("Expected MergeSort to allocate once, got %v allocations", allocs)
+	}
+}
+
+// naiveMergeSort is the original implementation, which allocates new left and right slices on every merge.
+// It is kept as a baseline for the benchmarks.
+func naiveMergeSort(arr []int, start, end int) {
+	if start < end {
+		mid := (start + end) / 2
+		naiveMergeSort(arr, start, mid)
+		naiveMergeSort(arr, mid+1, end)
+
+		left := slices.Clone(arr[start : mid+1])
+		right := slices.Clone(arr[mid+1 : end+1])
+		i, j, k := 0, 0, start
+		for i < len(left) && j < len(right) {
+			if left[i] <= right[j] {
+				arr[k] = left[i]
+				i++
+			} else {
+				arr[k] = right[j]
+				j++
+			}
+			k++
+		}
+		k += copy(arr[k:], left[i:])
+		copy(arr[k:], right[j:])
+	}
+}
+
+func benchmarkSort(b *testing.B, sort func([]int)) {
+	for _, n := range []int{1000, 100000} {
+		input := rand.New(rand.NewSource(1)).Perm(n)
+		arr := make([]int, n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				copy(arr, input)
+				sort(arr)
+			}
+		})
+	}
+}
+
+func BenchmarkNaiveMergeSort(b *testing.B) {
+	benchmarkSort(b, func(arr []int) { naiveMergeSort(arr, 0, len(arr)-1) })
+}
+
+func BenchmarkMergeSort(b *testing.B) {
+	benchmarkSort(b, func(arr []int) { MergeSort(arr, 0, len(arr)-1) })
+}
+
+func BenchmarkMergeSortBottomUp(b *testing.B) {
+	benchmarkSort(b, MergeSortBottomUp[int])
+}
+
+func BenchmarkMergeSortBuffer(b *testing.B) {
+	var buf []int
+	benchmarkSort(b, func(arr []int) {
+		if len(buf) < len(arr) {
+			buf = make([]int, len(arr))
+		}
+		MergeSortBuffer(arr, buf)
+	})
+}
@@ -0,0 +1,149 @@
+package sorting
+
+import (
+	"cmp"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// defaultParallelThreshold is the default length below which ParallelMergeSort sorts and merges sequentially.
+const defaultParallelThreshold = 1 << 13
+
+type parallelOptions struct {
+	workers   int
+	threshold int
+}
+
+// ParallelOption configures ParallelMergeSort.
+type ParallelOption func(*parallelOptions)
+
+// WithWorkers sets the maximum number of goroutines, including the caller's, that sort at the same time.
+// It defaults to runtime.GOMAXPROCS(0). Values below 1 are treated as 1, which sorts sequentially.
+func WithWorkers(n int) ParallelOption {
+	return func(o *parallelOptions) {
+		o.workers = max(n, 1)
+	}
+}
+
+// WithThreshold sets the length below which a part of the slice is sorted or merged without
+// splitting it further across goroutines. It defaults to 8192 and is never less than 2.
+func WithThreshold(n int) ParallelOption {
+	return func(o *parallelOptions) {
+		o.threshold = max(n, 2)
+	}
+}
+
+// parallelSorter holds the state shared by the goroutines of one ParallelMergeSort call.
+type parallelSorter[T cmp.Ordered] struct {
+	threshold int
+	// tokens bounds the number of extra goroutines; the caller's goroutine does not take a token.
+	tokens chan struct{}
+}
+
+// fork runs f and g, running f on a new goroutine if a token is free.
+func (s *parallelSorter[T]) fork(f, g func()) {
+	select {
+	case s.tokens <- struct{}{}:
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f()
+			<-s.tokens
+		}()
+		g()
+		wg.Wait()
+	default:
+		f()
+		g()
+	}
+}
+
+// sort sorts arr using buf, which must be as long as arr, as scratch space.
+func (s *parallelSorter[T]) sort(arr, buf []T) {
+	if len(arr) < s.threshold {
+		MergeSortBuffer(arr, buf)
+		return
+	}
+
+	mid := (len(arr) + 1) / 2
+	s.fork(
+		func() { s.sort(arr[:mid], buf[:mid]) },
+		func() { s.sort(arr[mid:], buf[mid:]) },
+	)
+	if arr[mid-1] <= arr[mid] {
+		return
+	}
+	s.merge(buf, arr[:mid], arr[mid:])
+	copy(arr, buf)
+}
+
+// merge stably merges the sorted slices a and b into dst, which must have room for both, splitting
+// the work around the median of the longer slice so that both halves can be merged in parallel.
+// Elements of a come before equal elements of b.
+func (s *parallelSorter[T]) merge(dst, a, b []T) {
+	if len(a)+len(b) < s.threshold {
+		mergeInto(dst, a, b)
+		return
+	}
+
+	var i, j int
+	if len(a) >= len(b) {
+		// Elements of b equal to a[i] must stay after it.
+		i = len(a) / 2
+		j = sort.Search(len(b), func(k int) bool { return b[k] >= a[i] })
+		dst[i+j] = a[i]
+		s.fork(
+			func() { s.merge(dst[:i+j], a[:i], b[:j]) },
+			func() { s.merge(dst[i+j+1:], a[i+1:], b[j:]) },
+		)
+		return
+	}
+
+	// Elements of a equal to b[j] must stay before it.
+	j = len(b) / 2
+	i = sort.Search(len(a), func(k int) bool { return a[k] > b[j] })
+	dst[i+j] = b[j]
+	s.fork(
+		func() { s.merge(dst[:i+j], a[:i], b[:j]) },
+		func() { s.merge(dst[i+j+1:], a[i:], b[j+1:]) },
+	)
+}
+
+// mergeInto sequentially merges the sorted slices a and b into dst, taking from a on ties.
+func mergeInto[T cmp.Ordered](dst, a, b []T) {
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if a[i] <= b[j] {
+			dst[k] = a[i]
+			i++
+		} else {
+			dst[k] = b[j]
+			j++
+		}
+		k++
+	}
+	k += copy(dst[k:], a[i:])
+	copy(dst[k:], b[j:])
+}
+
+// ParallelMergeSort sorts a slice using a merge sort that splits both the sorting and the merging
+// of large parts across a bounded number of goroutines. Parts shorter than the threshold are
+// sorted with MergeSortBuffer. The sort is stable and produces the same result as MergeSort.
+// It allocates a single scratch buffer as long as arr.
+func ParallelMergeSort[T cmp.Ordered](arr []T, opts ...ParallelOption) {
+	o := parallelOptions{workers: runtime.GOMAXPROCS(0), threshold: defaultParallelThreshold}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	buf := make([]T, len(arr))
+	if o.workers == 1 || len(arr) < o.threshold {
+		MergeSortBuffer(arr, buf)
+		return
+	}
+
+	s := &parallelSorter[T]{threshold: o.threshold, tokens: make(chan struct{}, o.workers-1)}
+	s.sort(arr, buf)
+}
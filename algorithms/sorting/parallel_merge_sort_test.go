@@ -0,0 +1,66 @@
+package sorting
+
+import (
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestParallelMergeSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name string
+		opts []ParallelOption
+	}{
+		{"defaults", nil},
+		{"single worker", []ParallelOption{WithWorkers(1)}},
+		{"invalid worker count", []ParallelOption{WithWorkers(-3), WithThreshold(16)}},
+		{"two workers", []ParallelOption{WithWorkers(2), WithThreshold(64)}},
+		{"many workers", []ParallelOption{WithWorkers(16), WithThreshold(32)}},
+		{"minimum threshold", []ParallelOption{WithWorkers(4), WithThreshold(0)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, n := range []int{0, 1, 2, 31, 32, 33, 1000, 20000} {
+				arr := make([]int, n)
+				for i := range arr {
+					// Few distinct values, so that splitting around equal elements is exercised.
+					arr[i] = rng.Intn(n/8 + 1)
+				}
+				expected := slices.Clone(arr)
+				MergeSort(expected, 0, len(expected)-1)
+
+				ParallelMergeSort(arr, tt.opts...)
+				if !slices.Equal(arr, expected) {
+					t.Errorf("wrong result for %d elements", n)
+				}
+			}
+		})
+	}
+}
+
+func TestParallelMergeSortPresorted(t *testing.T) {
+	ascending := make([]int, 5000)
+	descending := make([]int, 5000)
+	for i := range ascending {
+		ascending[i] = i
+		descending[i] = len(descending) - i
+	}
+	expected := slices.Sorted(slices.Values(descending))
+
+	ParallelMergeSort(ascending, WithThreshold(16))
+	ParallelMergeSort(descending, WithThreshold(16))
+	if !slices.IsSorted(ascending) || !slices.Equal(descending, expected) {
+		t.Error("Expected presorted inputs to be sorted")
+	}
+}
+
+func BenchmarkParallelMergeSort(b *testing.B) {
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run("workers="+strconv.Itoa(workers), func(b *testing.B) {
+			benchmarkSort(b, func(arr []int) { ParallelMergeSort(arr, WithWorkers(workers)) })
+		})
+	}
+}
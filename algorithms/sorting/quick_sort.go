@@ -0,0 +1,280 @@
+// Portions of this file are derived from the pdqsort implementation in the Go standard
+// library (src/slices/zsortanyfunc.go):
+//
+// Copyright 2022 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the NOTICE file.
+
+package sorting
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+type sortedHint int
+
+const (
+	unknownHint sortedHint = iota
+	increasingHint
+	decreasingHint
+)
+
+// QuickSortFunc sorts a slice in place using pattern-defeating quicksort (pdqsort), ordering elements
+// by the comparator cmp, which returns a negative value if a < b, zero if a == b and a positive value if a > b.
+//
+// Like introsort, it falls back to heapsort once the recursion gets too deep, so it runs in
+// O(n log n) in the worst case. It also sorts short ranges with insertion sort, shuffles
+// elements after unbalanced partitions to break adversarial patterns, finishes nearly sorted
+// inputs in linear time and groups elements equal to the pivot together, which makes inputs
+// with few distinct values cheap. The sort is not stable.
+func QuickSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	limit := bits.Len(uint(len(arr)))
+	pdqsort(arr, 0, len(arr), limit, cmp)
+}
+
+// QuickSort sorts a slice in place using pattern-defeating quicksort; see QuickSortFunc.
+// Like slices.Sort, it orders floating-point NaNs before all other values.
+func QuickSort[T cmp.Ordered](arr []T) {
+	QuickSortFunc(arr, cmp.Compare[T])
+}
+
+// pdqsort sorts arr[a:b]. limit is the number of unbalanced partitions allowed before switching to heapsort.
+// Every element before a, if any, is less than or equal to every element of arr[a:b].
+func pdqsort[T any](arr []T, a, b, limit int, cmp func(a, b T) int) {
+	wasBalanced, wasPartitioned := true, true
+
+	for {
+		length := b - a
+		if length <= insertionSortThreshold {
+			InsertionSortFunc(arr[a:b], cmp)
+			return
+		}
+		if limit == 0 {
+			HeapSortFunc(arr[a:b], cmp)
+			return
+		}
+		if !wasBalanced {
+			breakPatterns(arr[a:b])
+			limit--
+		}
+
+		pivot, hint := choosePivot(arr[a:b], cmp)
+		pivot += a
+		if hint == decreasingHint {
+			reverse(arr[a:b])
+			pivot = (b - 1) - (pivot - a)
+			hint = increasingHint
+		}
+
+		// The range looks sorted and the last partition did not move anything: try to finish cheaply.
+		if wasBalanced && wasPartitioned && hint == increasingHint && partialInsertionSort(arr[a:b], cmp) {
+			return
+		}
+
+		// The pivot equals the element before the range, which is a lower bound for it, so every
+		// element equal to the pivot can be skipped at once.
+		if a > 0 && cmp(arr[a-1], arr[pivot]) >= 0 {
+			a += partitionEqual(arr[a:b], pivot-a, cmp)
+			continue
+		}
+
+		mid, alreadyPartitioned := partition(arr[a:b], pivot-a, cmp)
+		mid += a
+		wasPartitioned = alreadyPartitioned
+
+		// Recurse into the smaller side and loop on the larger one, keeping the stack depth logarithmic.
+		leftLen, rightLen := mid-a, b-mid
+		balanceThreshold := length / 8
+		if leftLen < rightLen {
+			wasBalanced = leftLen >= balanceThreshold
+			pdqsort(arr, a, mid, limit, cmp)
+			a = mid + 1
+		} else {
+			wasBalanced = rightLen >= balanceThreshold
+			pdqsort(arr, mid+1, b, limit, cmp)
+			b = mid
+		}
+	}
+}
+
+// partition moves arr[pivot] to its final position mid, with smaller elements before it and
+// greater or equal elements after it. alreadyPartitioned reports whether no element had to move.
+func partition[T any](arr []T, pivot int, cmp func(a, b T) int) (mid int, alreadyPartitioned bool) {
+	arr[0], arr[pivot] = arr[pivot], arr[0]
+	i, j := 1, len(arr)-1
+
+	for i <= j && cmp(arr[i], arr[0]) < 0 {
+		i++
+	}
+	for i <= j && cmp(arr[j], arr[0]) >= 0 {
+		j--
+	}
+	if i > j {
+		arr[j], arr[0] = arr[0], arr[j]
+		return j, true
+	}
+	arr[i], arr[j] = arr[j], arr[i]
+	i++
+	j--
+
+	for {
+		for i <= j && cmp(arr[i], arr[0]) < 0 {
+			i++
+		}
+		for i <= j && cmp(arr[j], arr[0]) >= 0 {
+			j--
+		}
+		if i > j {
+			break
+		}
+		arr[i], arr[j] = arr[j], arr[i]
+		i++
+		j--
+	}
+	arr[j], arr[0] = arr[0], arr[j]
+	return j, false
+}
+
+// partitionEqual moves every element equal to arr[pivot] to the front of arr and returns how many there are.
+// arr[pivot] must be less than or equal to every element of arr.
+func partitionEqual[T any](arr []T, pivot int, cmp func(a, b T) int) int {
+	arr[0], arr[pivot] = arr[pivot], arr[0]
+	i, j := 1, len(arr)-1
+
+	for {
+		for i <= j && cmp(arr[0], arr[i]) >= 0 {
+			i++
+		}
+		for i <= j && cmp(arr[0], arr[j]) < 0 {
+			j--
+		}
+		if i > j {
+			break
+		}
+		arr[i], arr[j] = arr[j], arr[i]
+		i++
+		j--
+	}
+	return i
+}
+
+// partialInsertionSort sorts arr if it is nearly sorted, giving up after a few misplaced elements.
+// It reports whether arr ended up sorted.
+func partialInsertionSort[T any](arr []T, cmp func(a, b T) int) bool {
+	const (
+		maxSteps         = 5
+		shortestShifting = 50
+	)
+
+	i := 1
+	for step := 0; step < maxSteps; step++ {
+		for i < len(arr) && cmp(arr[i], arr[i-1]) >= 0 {
+			i++
+		}
+		if i == len(arr) {
+			return true
+		}
+		if len(arr) < shortestShifting {
+			return false
+		}
+		arr[i], arr[i-1] = arr[i-1], arr[i]
+
+		// Shift the smaller element left and the greater one right into place.
+		for j := i - 1; j >= 1 && cmp(arr[j], arr[j-1]) < 0; j-- {
+			arr[j], arr[j-1] = arr[j-1], arr[j]
+		}
+		for j := i + 1; j < len(arr) && cmp(arr[j], arr[j-1]) < 0; j++ {
+			arr[j], arr[j-1] = arr[j-1], arr[j]
+		}
+	}
+	return false
+}
+
+// breakPatterns swaps a few elements around the middle of arr with pseudo-random positions,
+// so that inputs crafted to produce unbalanced partitions cannot keep doing so.
+func breakPatterns[T any](arr []T) {
+	n := len(arr)
+	if n < 8 {
+		return
+	}
+
+	// xorshift seeded by the length keeps the sort deterministic.
+	seed := uint64(n)
+	next := func() uint64 {
+		seed ^= seed << 13
+		seed ^= seed >> 7
+		seed ^= seed << 17
+		return seed
+	}
+
+	mask := uint64(1)<<bits.Len(uint(n)) - 1
+	mid := n / 4 * 2
+	for i := mid - 1; i <= mid+1; i++ {
+		other := int(next() & mask)
+		if other >= n {
+			other -= n
+		}
+		arr[i], arr[other] = arr[other], arr[i]
+	}
+}
+
+// choosePivot returns the index of a pivot candidate in arr and a hint about the order of arr,
+// based on the number of swaps needed to take medians of a few samples.
+func choosePivot[T any](arr []T, cmp func(a, b T) int) (int, sortedHint) {
+	const (
+		shortestNinther = 50
+		maxSwaps        = 4 * 3
+	)
+
+	n := len(arr)
+	swaps := 0
+	a, b, c := n/4, n/4*2, n/4*3
+
+	if n >= 8 {
+		if n >= shortestNinther {
+			// Tukey's ninther: the median of the medians of three groups of three.
+			a = medianAdjacent(arr, a, &swaps, cmp)
+			b = medianAdjacent(arr, b, &swaps, cmp)
+			c = medianAdjacent(arr, c, &swaps, cmp)
+		}
+		b = median(arr, a, b, c, &swaps, cmp)
+	}
+
+	switch swaps {
+	case 0:
+		return b, increasingHint
+	case maxSwaps:
+		return b, decreasingHint
+	default:
+		return b, unknownHint
+	}
+}
+
+// order2 returns i and j ordered so that arr[i] <= arr[j], counting a swap if they were reversed.
+func order2[T any](arr []T, i, j int, swaps *int, cmp func(a, b T) int) (int, int) {
+	if cmp(arr[j], arr[i]) < 0 {
+		*swaps++
+		return j, i
+	}
+	return i, j
+}
+
+// median returns the index of the median of arr[a], arr[b] and arr[c].
+func median[T any](arr []T, a, b, c int, swaps *int, cmp func(a, b T) int) int {
+	a, b = order2(arr, a, b, swaps, cmp)
+	b, c = order2(arr, b, c, swaps, cmp)
+	_, b = order2(arr, a, b, swaps, cmp)
+	return b
+}
+
+// medianAdjacent returns the index of the median of arr[a-1], arr[a] and arr[a+1].
+func medianAdjacent[T any](arr []T, a int, swaps *int, cmp func(a, b T) int) int {
+	return median(arr, a-1, a, a+1, swaps, cmp)
+}
+
+func reverse[T any](arr []T) {
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
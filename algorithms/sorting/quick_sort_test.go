@@ -0,0 +1,72 @@
+package sorting
+
+import (
+	"math/bits"
+	"slices"
+	"testing"
+)
+
+// countingCompare returns a comparator that counts how many times it is called.
+func countingCompare(calls *int) func(a, b int) int {
+	return func(a, b int) int {
+		*calls++
+		return a - b
+	}
+}
+
+func TestQuickSortComparisonBound(t *testing.T) {
+	const n = 1 << 14
+	bound := 4 * n * bits.Len(n)
+
+	for _, g := range generators {
+		arr := g.generate(n)
+		calls := 0
+		QuickSortFunc(arr, countingCompare(&calls))
+		if !slices.IsSorted(arr) {
+			t.Errorf("%s input not sorted", g.name)
+		}
+		if calls > bound {
+			t.Errorf("%s input took %d comparisons, expected at most %d", g.name, calls, bound)
+		}
+	}
+}
+
+func TestQuickSortLinearOnSortedInput(t *testing.T) {
+	const n = 10000
+	for _, descending := range []bool{false, true} {
+		arr := make([]int, n)
+		for i := range arr {
+			arr[i] = i
+			if descending {
+				arr[i] = n - i
+			}
+		}
+
+		calls := 0
+		QuickSortFunc(arr, countingCompare(&calls))
+		if !slices.IsSorted(arr) || calls > 3*n {
+			t.Errorf("descending=%v: expected a linear number of comparisons, got %d", descending, calls)
+		}
+	}
+}
+
+func TestHeapSortFallback(t *testing.T) {
+	// With no partition budget left, pdqsort must hand the whole range to heapsort.
+	arr := []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10}
+	pdqsort(arr, 0, len(arr), 0, func(a, b int) int { return a - b })
+	if !slices.IsSorted(arr) {
+		t.Errorf("Expected sorted output, got %v", arr)
+	}
+}
+
+func BenchmarkQuickSort(b *testing.B) {
+	benchmarkSort(b, QuickSort[int])
+}
+
+func BenchmarkHeapSort(b *testing.B) {
+	benchmarkSort(b, HeapSort[int])
+}
+
+func BenchmarkSlicesSort(b *testing.B) {
+	benchmarkSort(b, slices.Sort[[]int])
+}
@@ -0,0 +1,207 @@
+package sorting
+
+import "unsafe"
+
+// Integer is the set of integer types accepted by the radix and counting sorts.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// integerLayout returns the width of K in bytes and the bit to flip so that the unsigned order
+// of uint64(v) ^ sign, restricted to its low width bytes, matches the order of K. For signed types
+// it is the sign bit, so that negative values come before non-negative ones.
+func integerLayout[K Integer]() (sign uint64, width int) {
+	var zero K
+	width = int(unsafe.Sizeof(zero))
+	if ^zero > 0 {
+		return 0, width
+	}
+	return uint64(1) << (8*width - 1), width
+}
+
+// integerKey returns a function mapping values of K to unsigned keys whose order matches the order of K.
+func integerKey[K Integer]() (func(K) uint64, int) {
+	sign, width := integerLayout[K]()
+	mask := uint64(1)<<(8*width) - 1 // shifting by 64 yields 0, so the mask is all ones for 64-bit types
+	return func(v K) uint64 { return (uint64(v) ^ sign) & mask }, width
+}
+
+// lsdRadixSort stably sorts arr by the unsigned keys returned by key, one byte at a time from the
+// least significant of the width low bytes. Passes in which every key has the same byte are skipped.
+func lsdRadixSort[T any](arr []T, key func(T) uint64, width int) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+
+	src, dst := arr, make([]T, n)
+	for shift := uint(0); shift < uint(8*width); shift += 8 {
+		var counts [256]int
+		for _, v := range src {
+			counts[byte(key(v)>>shift)]++
+		}
+		if counts[byte(key(src[0])>>shift)] == n {
+			continue
+		}
+
+		offset := 0
+		for i, c := range counts {
+			counts[i] = offset
+			offset += c
+		}
+		for _, v := range src {
+			b := byte(key(v) >> shift)
+			dst[counts[b]] = v
+			counts[b]++
+		}
+		src, dst = dst, src
+	}
+
+	if &src[0] != &arr[0] {
+		copy(arr, src)
+	}
+}
+
+// RadixSort sorts a slice of integers using least-significant-digit radix sort, one byte per pass,
+// in O(w * n) time for w-byte integers with a buffer of n elements. Negative values are ordered
+// before non-negative ones. Passes over bytes that are the same in every element are skipped.
+func RadixSort[T Integer](arr []T) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+
+	// This is lsdRadixSort with the key computed inline, which matters for large slices of integers.
+	sign, width := integerLayout[T]()
+	src, dst := arr, make([]T, n)
+	for shift := uint(0); shift < uint(8*width); shift += 8 {
+		var counts [256]int
+		for _, v := range src {
+			counts[byte((uint64(v)^sign)>>shift)]++
+		}
+		if counts[byte((uint64(src[0])^sign)>>shift)] == n {
+			continue
+		}
+
+		offset := 0
+		for i, c := range counts {
+			counts[i] = offset
+			offset += c
+		}
+		for _, v := range src {
+			b := byte((uint64(v) ^ sign) >> shift)
+			dst[counts[b]] = v
+			counts[b]++
+		}
+		src, dst = dst, src
+	}
+
+	if &src[0] != &arr[0] {
+		copy(arr, src)
+	}
+}
+
+// RadixSortFunc sorts a slice by the integer key that key extracts from each element, using
+// least-significant-digit radix sort. The sort is stable: elements with equal keys keep their
+// original order. key is called several times per element, so it should be cheap.
+func RadixSortFunc[T any, K Integer](arr []T, key func(T) K) {
+	toUnsigned, width := integerKey[K]()
+	lsdRadixSort(arr, func(v T) uint64 { return toUnsigned(key(v)) }, width)
+}
+
+// msdThreshold is the bucket size below which the string radix sort switches to insertion sort.
+const msdThreshold = 32
+
+// msdTask is a range of the slice whose keys share their first depth bytes.
+type msdTask struct {
+	lo, hi, depth int
+}
+
+// byteAt returns the byte of s at depth d shifted up by one, or 0 if s is only d bytes long,
+// so that shorter strings sort before their extensions.
+func byteAt[S ~string | ~[]byte](s S, d int) int {
+	if d < len(s) {
+		return int(s[d]) + 1
+	}
+	return 0
+}
+
+// compareFrom compares a and b lexicographically, ignoring their first d bytes.
+func compareFrom[S ~string | ~[]byte](a, b S, d int) int {
+	for ; d < len(a) && d < len(b); d++ {
+		if a[d] != b[d] {
+			return int(a[d]) - int(b[d])
+		}
+	}
+	return len(a) - len(b)
+}
+
+// msdRadixSort stably sorts arr by the byte strings returned by key, distributing elements into
+// buckets by one byte at a time from the most significant. Work is kept on an explicit list rather
+// than the call stack, so very long common prefixes cannot overflow the goroutine stack.
+func msdRadixSort[T any, S ~string | ~[]byte](arr []T, key func(T) S) {
+	if len(arr) < 2 {
+		return
+	}
+
+	buf := make([]T, len(arr))
+	tasks := []msdTask{{lo: 0, hi: len(arr)}}
+	for len(tasks) > 0 {
+		task := tasks[len(tasks)-1]
+		tasks = tasks[:len(tasks)-1]
+		part := arr[task.lo:task.hi]
+
+		if len(part) < msdThreshold {
+			InsertionSortFunc(part, func(a, b T) int { return compareFrom(key(a), key(b), task.depth) })
+			continue
+		}
+
+		var counts [257]int
+		for _, v := range part {
+			counts[byteAt(key(v), task.depth)]++
+		}
+
+		starts := counts
+		offset := 0
+		for i, c := range counts {
+			starts[i] = offset
+			offset += c
+		}
+		next := starts
+		for _, v := range part {
+			b := byteAt(key(v), task.depth)
+			buf[next[b]] = v
+			next[b]++
+		}
+		copy(part, buf[:len(part)])
+
+		// Bucket 0 holds keys that end at this depth, which are all equal.
+		for b := 1; b < len(counts); b++ {
+			if counts[b] > 1 {
+				lo := task.lo + starts[b]
+				tasks = append(tasks, msdTask{lo: lo, hi: lo + counts[b], depth: task.depth + 1})
+			}
+		}
+	}
+}
+
+// RadixSortStrings sorts a slice of strings in byte-wise lexicographic order, the order of the
+// < operator, using most-significant-digit radix sort. It takes O(n + total length of the
+// distinguishing prefixes) time and falls back to insertion sort for small buckets.
+func RadixSortStrings[S ~string](arr []S) {
+	msdRadixSort(arr, func(s S) S { return s })
+}
+
+// RadixSortBytes sorts a slice of byte slices in the order of bytes.Compare using
+// most-significant-digit radix sort.
+func RadixSortBytes[B ~[]byte](arr []B) {
+	msdRadixSort(arr, func(b B) B { return b })
+}
+
+// RadixSortStringsFunc sorts a slice by the string key that key extracts from each element, using
+// most-significant-digit radix sort. The sort is stable: elements with equal keys keep their
+// original order. key is called several times per element, so it should be cheap.
+func RadixSortStringsFunc[T any](arr []T, key func(T) string) {
+	msdRadixSort(arr, key)
+}
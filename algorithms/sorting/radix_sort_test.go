@@ -0,0 +1,180 @@
+package sorting
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// checkIntegerSort sorts a clone of input with sort and compares the result against slices.Sort.
+func checkIntegerSort[T Integer](t *testing.T, name string, sort func([]T), input []T) {
+	t.Helper()
+	expected := slices.Clone(input)
+	slices.Sort(expected)
+
+	arr := slices.Clone(input)
+	sort(arr)
+	if !slices.Equal(arr, expected) {
+		t.Errorf("%s: got %v, expected %v", name, arr, expected)
+	}
+}
+
+func TestRadixSortIntegerTypes(t *testing.T) {
+	checkIntegerSort(t, "int8", RadixSort[int8], []int8{0, -1, 127, -128, 5, -5, 1, -127, 126})
+	checkIntegerSort(t, "uint8", RadixSort[uint8], []uint8{255, 0, 128, 127, 1, 254})
+	checkIntegerSort(t, "int16", RadixSort[int16], []int16{math.MinInt16, 300, -300, math.MaxInt16, 0, -1})
+	checkIntegerSort(t, "uint32", RadixSort[uint32], []uint32{math.MaxUint32, 1 << 24, 1 << 8, 0, 1 << 16})
+	checkIntegerSort(t, "int64", RadixSort[int64], []int64{math.MinInt64, math.MaxInt64, -1, 0, 1, -1 << 40, 1 << 40})
+	checkIntegerSort(t, "uint64", RadixSort[uint64], []uint64{math.MaxUint64, 0, 1 << 63, 1<<63 - 1, 42})
+	checkIntegerSort(t, "uintptr", RadixSort[uintptr], []uintptr{3, 1, 2})
+
+	type id int32
+	checkIntegerSort(t, "named type", RadixSort[id], []id{7, -3, 0, math.MinInt32, math.MaxInt32})
+}
+
+func TestRadixSortUint64Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	input := make([]uint64, 10000)
+	for i := range input {
+		input[i] = rng.Uint64()
+	}
+	checkIntegerSort(t, "random uint64", RadixSort[uint64], input)
+}
+
+func TestRadixSortStrings(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name  string
+		input []string
+	}{
+		{"empty", nil},
+		{"prefixes", []string{"abc", "ab", "", "a", "abcd", "abc", "b", "ab"}},
+		{"non-ascii", []string{"zebra", "éclair", "apple", "\xff", "\x00", "Zebra", "äpfel"}},
+	}
+
+	// Many strings sharing long prefixes exercise the bucketing beyond the insertion sort threshold.
+	var shared []string
+	for range 2000 {
+		shared = append(shared, "prefix/"+strconv.Itoa(rng.Intn(500))+"/"+strconv.Itoa(rng.Intn(10)))
+	}
+	tests = append(tests, struct {
+		name  string
+		input []string
+	}{"shared prefixes", shared})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := slices.Clone(tt.input)
+			slices.Sort(expected)
+
+			arr := slices.Clone(tt.input)
+			RadixSortStrings(arr)
+			if !slices.Equal(arr, expected) {
+				t.Errorf("RadixSortStrings() = %q, expected %q", arr, expected)
+			}
+
+			bs := make([][]byte, len(tt.input))
+			for i, s := range tt.input {
+				bs[i] = []byte(s)
+			}
+			RadixSortBytes(bs)
+			for i := range bs {
+				if string(bs[i]) != expected[i] {
+					t.Fatalf("RadixSortBytes() differs at %d: %q, expected %q", i, bs[i], expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRadixSortStringsFuncStable(t *testing.T) {
+	type user struct {
+		country string
+		id      int
+	}
+	var users []user
+	countries := []string{"br", "de", "fr", "us", "usa", "b"}
+	for i := range 200 {
+		users = append(users, user{countries[(i*7)%len(countries)], i})
+	}
+
+	expected := slices.Clone(users)
+	slices.SortStableFunc(expected, func(a, b user) int {
+		if a.country < b.country {
+			return -1
+		}
+		if a.country > b.country {
+			return 1
+		}
+		return 0
+	})
+
+	RadixSortStringsFunc(users, func(u user) string { return u.country })
+	if !slices.Equal(users, expected) {
+		t.Error("Expected RadixSortStringsFunc to be a stable sort by country")
+	}
+}
+
+func TestRadixSortStringsLongCommonPrefix(t *testing.T) {
+	// Bucketing one byte per level would recurse once per byte of the common prefix.
+	prefix := string(make([]byte, 100000))
+	arr := make([]string, 64)
+	for i := range arr {
+		arr[i] = prefix + strconv.Itoa(len(arr)-i)
+	}
+	expected := slices.Clone(arr)
+	slices.Sort(expected)
+
+	RadixSortStrings(arr)
+	if !slices.Equal(arr, expected) {
+		t.Error("Expected strings with a long common prefix to be sorted")
+	}
+}
+
+func BenchmarkRadixSortUint64(b *testing.B) {
+	for _, n := range []int{1000, 100000} {
+		rng := rand.New(rand.NewSource(1))
+		input := make([]uint64, n)
+		for i := range input {
+			input[i] = rng.Uint64()
+		}
+		arr := make([]uint64, n)
+
+		b.Run(strconv.Itoa(n)+"/RadixSort", func(b *testing.B) {
+			for b.Loop() {
+				copy(arr, input)
+				RadixSort(arr)
+			}
+		})
+		b.Run(strconv.Itoa(n)+"/slices.Sort", func(b *testing.B) {
+			for b.Loop() {
+				copy(arr, input)
+				slices.Sort(arr)
+			}
+		})
+	}
+}
+
+func BenchmarkRadixSortStrings(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	input := make([]string, 100000)
+	for i := range input {
+		input[i] = strconv.FormatUint(rng.Uint64(), 36)
+	}
+	arr := make([]string, len(input))
+
+	b.Run("RadixSortStrings", func(b *testing.B) {
+		for b.Loop() {
+			copy(arr, input)
+			RadixSortStrings(arr)
+		}
+	})
+	b.Run("slices.Sort", func(b *testing.B) {
+		for b.Loop() {
+			copy(arr, input)
+			slices.Sort(arr)
+		}
+	})
+}
@@ -0,0 +1,281 @@
+package sorting
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// This file is a property-test harness: every sorting algorithm in the package is run on the same
+// generated inputs and its output is compared against slices.Sort, or slices.SortStableFunc for the
+// stable comparator-based sorts.
+
+type orderedSort struct {
+	name string
+	sort func([]int)
+	// nanSafe reports whether the sort orders NaNs like slices.Sort, which only holds for the sorts built on cmp.Compare.
+	nanSafe   bool
+	sortFloat func([]float64)
+}
+
+var orderedSorts = []orderedSort{
+	{"InsertionSort", InsertionSort[int], false, InsertionSort[float64]},
+	{"MergeSort", func(a []int) { MergeSort(a, 0, len(a)-1) }, false, func(a []float64) { MergeSort(a, 0, len(a)-1) }},
+	{"MergeSortBottomUp", MergeSortBottomUp[int], false, MergeSortBottomUp[float64]},
+	{"ParallelMergeSort", func(a []int) { ParallelMergeSort(a, WithThreshold(64)) }, false, func(a []float64) { ParallelMergeSort(a, WithThreshold(64)) }},
+	{"HeapSort", HeapSort[int], true, HeapSort[float64]},
+	{"QuickSort", QuickSort[int], true, QuickSort[float64]},
+	{"TimSort", TimSort[int], true, TimSort[float64]},
+}
+
+type funcSort struct {
+	name   string
+	sort   func([]record, func(a, b record) int)
+	stable bool
+}
+
+var funcSorts = []funcSort{
+	{"InsertionSortFunc", InsertionSortFunc[record], true},
+	{"MergeSortFunc", func(a []record, cmp func(a, b record) int) { MergeSortFunc(a, 0, len(a)-1, cmp) }, true},
+	{"HeapSortFunc", HeapSortFunc[record], false},
+	{"QuickSortFunc", QuickSortFunc[record], false},
+	{"TimSortFunc", TimSortFunc[record], true},
+}
+
+// record carries its original position so that stability can be checked.
+type record struct {
+	key, pos int
+}
+
+func byKey(a, b record) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+// generator produces inputs of length n with a pattern that is known to trip up sorting algorithms.
+type generator struct {
+	name string
+	gen  func(rng *rand.Rand, n int) []int
+}
+
+// generate returns the input of length n, drawn from an RNG seeded by n alone, so that any
+// failing case can be reproduced on its own.
+func (g generator) generate(n int) []int {
+	return g.gen(rand.New(rand.NewSource(int64(n))), n)
+}
+
+// generators are listed in a fixed order so that test output is stable between runs.
+var generators = []generator{
+	{"random", func(rng *rand.Rand, n int) []int {
+		return rng.Perm(n)
+	}},
+	{"few unique", func(rng *rand.Rand, n int) []int {
+		arr := make([]int, n)
+		for i := range arr {
+			arr[i] = rng.Intn(4)
+		}
+		return arr
+	}},
+	{"all equal", func(_ *rand.Rand, n int) []int {
+		return make([]int, n)
+	}},
+	{"sorted", func(_ *rand.Rand, n int) []int {
+		arr := make([]int, n)
+		for i := range arr {
+			arr[i] = i
+		}
+		return arr
+	}},
+	{"reversed", func(_ *rand.Rand, n int) []int {
+		arr := make([]int, n)
+		for i := range arr {
+			arr[i] = n - i
+		}
+		return arr
+	}},
+	{"nearly sorted", func(rng *rand.Rand, n int) []int {
+		arr := make([]int, n)
+		for i := range arr {
+			arr[i] = i
+		}
+		for range n/20 + 1 {
+			if n > 1 {
+				i, j := rng.Intn(n), rng.Intn(n)
+				arr[i], arr[j] = arr[j], arr[i]
+			}
+		}
+		return arr
+	}},
+	{"sawtooth", func(_ *rand.Rand, n int) []int {
+		arr := make([]int, n)
+		for i := range arr {
+			arr[i] = i % 37
+		}
+		return arr
+	}},
+	{"organ pipe", func(_ *rand.Rand, n int) []int {
+		arr := make([]int, n)
+		for i := range arr {
+			arr[i] = min(i, n-i)
+		}
+		return arr
+	}},
+	{"runs", func(rng *rand.Rand, n int) []int {
+		arr := rng.Perm(n)
+		for lo := 0; lo < n; lo += 100 {
+			part := arr[lo:min(lo+100, n)]
+			slices.Sort(part)
+			if lo/100%2 == 1 {
+				slices.Reverse(part)
+			}
+		}
+		return arr
+	}},
+}
+
+var sizes = []int{0, 1, 2, 3, 7, 12, 13, 31, 32, 33, 64, 65, 100, 1000, 5000}
+
+func TestOrderedSortsMatchSlicesSort(t *testing.T) {
+	for _, g := range generators {
+		for _, n := range sizes {
+			input := g.generate(n)
+			expected := slices.Clone(input)
+			slices.Sort(expected)
+
+			for _, s := range orderedSorts {
+				arr := slices.Clone(input)
+				s.sort(arr)
+				if !slices.Equal(arr, expected) {
+					t.Errorf("%s: wrong result for %s input of %d elements", s.name, g.name, n)
+				}
+			}
+		}
+	}
+}
+
+func TestOrderedSortsFloats(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	special := []float64{math.Inf(1), math.Inf(-1), 0, math.Copysign(0, -1)}
+
+	for _, n := range sizes {
+		input := make([]float64, n)
+		for i := range input {
+			input[i] = rng.NormFloat64()
+			if rng.Intn(10) == 0 {
+				input[i] = special[rng.Intn(len(special))]
+			}
+		}
+		withNaN := slices.Clone(input)
+		for i := range withNaN {
+			if rng.Intn(8) == 0 {
+				withNaN[i] = math.NaN()
+			}
+		}
+
+		for _, s := range orderedSorts {
+			arr := slices.Clone(input)
+			s.sortFloat(arr)
+			if !slices.IsSorted(arr) {
+				t.Errorf("%s: floats of length %d not sorted", s.name, n)
+			}
+
+			if !s.nanSafe {
+				continue
+			}
+			expected := slices.Clone(withNaN)
+			slices.Sort(expected)
+			arr = slices.Clone(withNaN)
+			s.sortFloat(arr)
+			// NaN != NaN, so compare with cmp.Compare, which treats NaNs as equal.
+			if !slices.EqualFunc(arr, expected, func(a, b float64) bool { return cmp.Compare(a, b) == 0 }) {
+				t.Errorf("%s: floats with NaNs of length %d not ordered like slices.Sort", s.name, n)
+			}
+		}
+	}
+}
+
+func TestFuncSortsMatchSlicesSort(t *testing.T) {
+	for _, g := range generators {
+		for _, n := range sizes {
+			input := make([]record, n)
+			for i, key := range g.generate(n) {
+				input[i] = record{key: key, pos: i}
+			}
+			stable := slices.Clone(input)
+			slices.SortStableFunc(stable, byKey)
+
+			for _, s := range funcSorts {
+				arr := slices.Clone(input)
+				s.sort(arr, byKey)
+
+				if s.stable {
+					if !slices.Equal(arr, stable) {
+						t.Errorf("%s: not a stable sort of %s input of %d elements", s.name, g.name, n)
+					}
+					continue
+				}
+				if !slices.IsSortedFunc(arr, byKey) {
+					t.Errorf("%s: wrong result for %s input of %d elements", s.name, g.name, n)
+				}
+				slices.SortFunc(arr, func(a, b record) int { return cmp.Compare(a.pos, b.pos) })
+				if !slices.Equal(arr, input) {
+					t.Errorf("%s: result of %s input of %d elements is not a permutation of the input", s.name, g.name, n)
+				}
+			}
+		}
+	}
+}
+
+var integerSorts = []struct {
+	name string
+	sort func([]int)
+}{
+	{"RadixSort", RadixSort[int]},
+	{"CountingSort", CountingSort[int]},
+}
+
+var keySorts = []struct {
+	name string
+	sort func([]record, func(record) int)
+}{
+	{"RadixSortFunc", RadixSortFunc[record, int]},
+	{"CountingSortFunc", CountingSortFunc[record, int]},
+}
+
+func TestIntegerSortsMatchSlicesSort(t *testing.T) {
+	for _, g := range generators {
+		for _, n := range sizes {
+			input := g.generate(n)
+			// Shift the values so that half of them are negative.
+			for i := range input {
+				input[i] -= n / 2
+			}
+			expected := slices.Clone(input)
+			slices.Sort(expected)
+
+			for _, s := range integerSorts {
+				arr := slices.Clone(input)
+				s.sort(arr)
+				if !slices.Equal(arr, expected) {
+					t.Errorf("%s: wrong result for %s input of %d elements", s.name, g.name, n)
+				}
+			}
+
+			records := make([]record, n)
+			for i, key := range input {
+				records[i] = record{key: key, pos: i}
+			}
+			stable := slices.Clone(records)
+			slices.SortStableFunc(stable, byKey)
+
+			for _, s := range keySorts {
+				arr := slices.Clone(records)
+				s.sort(arr, func(r record) int { return r.key })
+				if !slices.Equal(arr, stable) {
+					t.Errorf("%s: not a stable sort of %s input of %d elements", s.name, g.name, n)
+				}
+			}
+		}
+	}
+}
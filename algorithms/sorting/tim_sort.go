@@ -0,0 +1,211 @@
+package sorting
+
+import (
+	"cmp"
+	"sort"
+)
+
+// minMerge is the length below which TimSort sorts the whole slice with binary insertion sort,
+// and the upper bound of the minimum run length.
+const minMerge = 32
+
+// timRun is a sorted run arr[start : start+length] waiting on the TimSort run stack.
+type timRun struct {
+	start, length int
+}
+
+// timSorter holds the state of one TimSortFunc call.
+type timSorter[T any] struct {
+	arr  []T
+	cmp  func(a, b T) int
+	buf  []T // scratch space for merges, grown on demand up to len(arr)/2
+	runs []timRun
+}
+
+// TimSortFunc sorts a slice using TimSort, ordering elements by the comparator cmp, which returns a
+// negative value if a < b, zero if a == b and a positive value if a > b.
+//
+// TimSort finds the ascending and strictly descending runs already present in the input, extends
+// short runs to a minimum length with binary insertion sort, and merges runs of similar lengths
+// until one remains. Sorted, reversed and partially sorted inputs take O(n) time, and the worst
+// case is O(n log n). The sort is stable and uses at most len(arr)/2 elements of extra space.
+func TimSortFunc[T any](arr []T, cmp func(a, b T) int) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+	if n < minMerge {
+		binaryInsertionSort(arr, countRun(arr, cmp), cmp)
+		return
+	}
+
+	s := &timSorter[T]{arr: arr, cmp: cmp}
+	minRun := minRunLength(n)
+	for lo := 0; lo < n; {
+		length := countRun(arr[lo:], cmp)
+		if length < minRun {
+			forced := min(minRun, n-lo)
+			binaryInsertionSort(arr[lo:lo+forced], length, cmp)
+			length = forced
+		}
+
+		s.runs = append(s.runs, timRun{start: lo, length: length})
+		s.mergeCollapse()
+		lo += length
+	}
+	s.mergeForceCollapse()
+}
+
+// TimSort sorts a slice using TimSort; see TimSortFunc. The sort is stable.
+// Like slices.Sort, it orders floating-point NaNs before all other values.
+func TimSort[T cmp.Ordered](arr []T) {
+	TimSortFunc(arr, cmp.Compare[T])
+}
+
+// countRun returns the length of the run at the start of arr, reversing it first if it is strictly
+// descending. Only strictly descending runs are reversed, so equal elements keep their order.
+func countRun[T any](arr []T, cmp func(a, b T) int) int {
+	if len(arr) < 2 {
+		return len(arr)
+	}
+
+	end := 2
+	if cmp(arr[1], arr[0]) < 0 {
+		for end < len(arr) && cmp(arr[end], arr[end-1]) < 0 {
+			end++
+		}
+		reverse(arr[:end])
+	} else {
+		for end < len(arr) && cmp(arr[end], arr[end-1]) >= 0 {
+			end++
+		}
+	}
+	return end
+}
+
+// binaryInsertionSort sorts arr, whose first sorted elements are already in order, inserting each
+// following element after every element that is less than or equal to it.
+func binaryInsertionSort[T any](arr []T, sorted int, cmp func(a, b T) int) {
+	for i := max(sorted, 1); i < len(arr); i++ {
+		pivot := arr[i]
+		pos := sort.Search(i, func(k int) bool { return cmp(pivot, arr[k]) < 0 })
+		copy(arr[pos+1:i+1], arr[pos:i])
+		arr[pos] = pivot
+	}
+}
+
+// minRunLength returns the minimum run length for n elements: a value in [minMerge/2, minMerge]
+// such that n / minRun is a power of two or slightly less, which keeps the final merges balanced.
+func minRunLength(n int) int {
+	r := 0
+	for n >= minMerge {
+		r |= n & 1
+		n >>= 1
+	}
+	return n + r
+}
+
+// mergeCollapse merges runs on the stack until the lengths of the top runs satisfy the TimSort
+// invariants: each run is longer than the sum of the two runs above it, and longer than the run
+// above it. The invariants are checked on the top four runs, which fixes the flaw in the original
+// formulation that only checked three.
+func (s *timSorter[T]) mergeCollapse() {
+	for len(s.runs) > 1 {
+		n := len(s.runs) - 2
+		runs := s.runs
+		if (n > 0 && runs[n-1].length <= runs[n].length+runs[n+1].length) ||
+			(n > 1 && runs[n-2].length <= runs[n-1].length+runs[n].length) {
+			if runs[n-1].length < runs[n+1].length {
+				n--
+			}
+		} else if runs[n].length > runs[n+1].length {
+			return
+		}
+		s.mergeAt(n)
+	}
+}
+
+// mergeForceCollapse merges every run on the stack into one.
+func (s *timSorter[T]) mergeForceCollapse() {
+	for len(s.runs) > 1 {
+		n := len(s.runs) - 2
+		if n > 0 && s.runs[n-1].length < s.runs[n+1].length {
+			n--
+		}
+		s.mergeAt(n)
+	}
+}
+
+// mergeAt merges the runs at positions i and i+1 of the stack.
+func (s *timSorter[T]) mergeAt(i int) {
+	a, b := s.runs[i], s.runs[i+1]
+	s.runs[i].length += b.length
+	s.runs = append(s.runs[:i+1], s.runs[i+2:]...)
+
+	lo, mid, hi := a.start, b.start, b.start+b.length
+
+	// Elements of a that are not greater than the first element of b are already in place,
+	// and so are the elements of b that are not less than the last element of a.
+	first := s.arr[mid]
+	lo += sort.Search(mid-lo, func(k int) bool { return s.cmp(first, s.arr[lo+k]) < 0 })
+	if lo == mid {
+		return
+	}
+	last := s.arr[mid-1]
+	hi = mid + sort.Search(hi-mid, func(k int) bool { return s.cmp(s.arr[mid+k], last) >= 0 })
+	if hi == mid {
+		return
+	}
+
+	if mid-lo <= hi-mid {
+		s.mergeLo(lo, mid, hi)
+	} else {
+		s.mergeHi(lo, mid, hi)
+	}
+}
+
+// scratch returns a buffer of n elements, growing the shared buffer if needed.
+func (s *timSorter[T]) scratch(n int) []T {
+	if len(s.buf) < n {
+		s.buf = make([]T, max(n, min(2*len(s.buf), len(s.arr)/2)))
+	}
+	return s.buf[:n]
+}
+
+// mergeLo merges arr[lo:mid] and arr[mid:hi] front to back, copying the shorter left run aside.
+func (s *timSorter[T]) mergeLo(lo, mid, hi int) {
+	left := s.scratch(mid - lo)
+	copy(left, s.arr[lo:mid])
+
+	i, j, k := 0, mid, lo
+	for i < len(left) && j < hi {
+		if s.cmp(s.arr[j], left[i]) < 0 {
+			s.arr[k] = s.arr[j]
+			j++
+		} else {
+			s.arr[k] = left[i]
+			i++
+		}
+		k++
+	}
+	copy(s.arr[k:], left[i:])
+}
+
+// mergeHi merges arr[lo:mid] and arr[mid:hi] back to front, copying the shorter right run aside.
+func (s *timSorter[T]) mergeHi(lo, mid, hi int) {
+	right := s.scratch(hi - mid)
+	copy(right, s.arr[mid:hi])
+
+	i, j, k := mid-1, len(right)-1, hi-1
+	for i >= lo && j >= 0 {
+		if s.cmp(right[j], s.arr[i]) < 0 {
+			s.arr[k] = s.arr[i]
+			i--
+		} else {
+			s.arr[k] = right[j]
+			j--
+		}
+		k--
+	}
+	copy(s.arr[lo:], right[:j+1])
+}
@@ -0,0 +1,72 @@
+package sorting
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMinRunLength(t *testing.T) {
+	tests := []struct {
+		n, expected int
+	}{
+		{31, 31},
+		{32, 16},
+		{33, 17},
+		{64, 16},
+		{65, 17},
+		{1 << 20, 16},
+		{(1 << 20) + 1, 17},
+	}
+
+	for _, tt := range tests {
+		if got := minRunLength(tt.n); got != tt.expected {
+			t.Errorf("minRunLength(%d) = %d, expected %d", tt.n, got, tt.expected)
+		}
+	}
+}
+
+func TestTimSortNaturalRuns(t *testing.T) {
+	const n = 10000
+	tests := []struct {
+		name     string
+		generate func(i int) int
+	}{
+		{"ascending", func(i int) int { return i }},
+		{"descending", func(i int) int { return n - i }},
+		{"constant", func(i int) int { return 7 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arr := make([]int, n)
+			for i := range arr {
+				arr[i] = tt.generate(i)
+			}
+
+			calls := 0
+			TimSortFunc(arr, countingCompare(&calls))
+			if !slices.IsSorted(arr) {
+				t.Fatal("Expected sorted output")
+			}
+			// A single natural run is detected with n-1 comparisons and needs no merging.
+			if calls != n-1 {
+				t.Errorf("Expected %d comparisons, got %d", n-1, calls)
+			}
+		})
+	}
+}
+
+func TestTimSortFuncStableDescending(t *testing.T) {
+	// Equal keys inside a descending run must not be reversed.
+	arr := []record{{3, 0}, {2, 1}, {2, 2}, {1, 3}, {1, 4}, {0, 5}}
+	TimSortFunc(arr, byKey)
+
+	expected := []record{{0, 5}, {1, 3}, {1, 4}, {2, 1}, {2, 2}, {3, 0}}
+	if !slices.Equal(arr, expected) {
+		t.Errorf("Expected %v, got %v", expected, arr)
+	}
+}
+
+func BenchmarkTimSort(b *testing.B) {
+	benchmarkSort(b, TimSort[int])
+}
@@ -0,0 +1,19 @@
+package toposort
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/codeYann/go-collections/algorithms/internal/adjmap"
+)
+
+// DFS returns a topological order of the graph computed as the reverse postorder of an iterative
+// depth-first search, in O(V + E). If the graph has a cycle it returns a *CycleError holding one.
+func DFS[V cmp.Ordered](graph map[V][]V) ([]V, error) {
+	order, cycle := dfs(graph, adjmap.Vertices(graph))
+	if cycle != nil {
+		return nil, &CycleError[V]{Cycle: cycle}
+	}
+	slices.Reverse(order)
+	return order, nil
+}
@@ -0,0 +1,43 @@
+package toposort
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDFS(t *testing.T) {
+	order, err := DFS(build)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	checkOrder(t, build, order)
+
+	expected := []string{"fetch", "lint", "codegen", "compile", "test", "package", "publish"}
+	if !slices.Equal(order, expected) {
+		t.Errorf("Expected %v, got %v", expected, order)
+	}
+}
+
+func TestDFSCycle(t *testing.T) {
+	_, err := DFS(cyclic)
+	checkCycle(t, cyclic, err)
+}
+
+func TestDFSDeepChain(t *testing.T) {
+	// A recursive implementation would need one goroutine stack frame per vertex.
+	const n = 200000
+	graph := make(map[int][]int, n)
+	for i := range n - 1 {
+		graph[i] = []int{i + 1}
+	}
+
+	order, err := DFS(graph)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for i, v := range order {
+		if v != i {
+			t.Fatalf("Expected %d at index %d, got %d", i, i, v)
+		}
+	}
+}
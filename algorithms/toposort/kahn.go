@@ -0,0 +1,99 @@
+package toposort
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/codeYann/go-collections/algorithms/internal/adjmap"
+	"github.com/codeYann/go-collections/queue"
+)
+
+// inDegrees returns the number of edges entering each vertex of the graph.
+func inDegrees[V cmp.Ordered](graph map[V][]V, all []V) map[V]int {
+	degree := make(map[V]int, len(all))
+	for _, edges := range graph {
+		for _, v := range edges {
+			degree[v]++
+		}
+	}
+	return degree
+}
+
+// cycleError builds the error returned when Kahn's algorithm stops before ordering every vertex.
+func cycleError[V cmp.Ordered](graph map[V][]V) error {
+	cycle, _ := FindCycle(graph)
+	return &CycleError[V]{Cycle: cycle}
+}
+
+// Kahn returns a topological order of the graph using Kahn's algorithm in O(V + E): vertices with
+// no remaining incoming edges are emitted first-in first-out, starting from the sources in ascending
+// order. If the graph has a cycle it returns a *CycleError holding one.
+func Kahn[V cmp.Ordered](graph map[V][]V) ([]V, error) {
+	all := adjmap.Vertices(graph)
+	degree := inDegrees(graph, all)
+
+	// Every vertex is enqueued at most once; Queue keeps one slot free.
+	q, _ := queue.NewQueue[V](uint(len(all) + 1))
+	for _, v := range all {
+		if degree[v] == 0 {
+			q.Enqueue(v)
+		}
+	}
+
+	order := make([]V, 0, len(all))
+	for !q.IsEmpty() {
+		u, _ := q.Dequeue()
+		order = append(order, u)
+		for _, v := range graph[u] {
+			degree[v]--
+			if degree[v] == 0 {
+				q.Enqueue(v)
+			}
+		}
+	}
+
+	if len(order) < len(all) {
+		return nil, cycleError(graph)
+	}
+	return order, nil
+}
+
+// Layers groups the vertices of the graph into layers that can be processed in parallel:
+// layer 0 holds the vertices with no incoming edges, and every other vertex is in the layer
+// right after the last of its predecessors. Each layer is sorted in ascending order.
+// If the graph has a cycle it returns a *CycleError holding one.
+func Layers[V cmp.Ordered](graph map[V][]V) ([][]V, error) {
+	all := adjmap.Vertices(graph)
+	degree := inDegrees(graph, all)
+
+	var layer []V
+	for _, v := range all {
+		if degree[v] == 0 {
+			layer = append(layer, v)
+		}
+	}
+
+	var layers [][]V
+	placed := 0
+	for len(layer) > 0 {
+		layers = append(layers, layer)
+		placed += len(layer)
+
+		var next []V
+		for _, u := range layer {
+			for _, v := range graph[u] {
+				degree[v]--
+				if degree[v] == 0 {
+					next = append(next, v)
+				}
+			}
+		}
+		slices.Sort(next)
+		layer = next
+	}
+
+	if placed < len(all) {
+		return nil, cycleError(graph)
+	}
+	return layers, nil
+}
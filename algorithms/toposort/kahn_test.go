@@ -0,0 +1,55 @@
+package toposort
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestKahn(t *testing.T) {
+	order, err := Kahn(build)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	checkOrder(t, build, order)
+
+	expected := []string{"codegen", "fetch", "compile", "lint", "test", "package", "publish"}
+	if !slices.Equal(order, expected) {
+		t.Errorf("Expected %v, got %v", expected, order)
+	}
+}
+
+func TestKahnCycle(t *testing.T) {
+	_, err := Kahn(cyclic)
+	checkCycle(t, cyclic, err)
+}
+
+func TestKahnParallelEdges(t *testing.T) {
+	graph := map[string][]string{"a": {"b", "b"}, "b": nil}
+	order, err := Kahn(graph)
+	if err != nil || !slices.Equal(order, []string{"a", "b"}) {
+		t.Errorf("Expected [a b], got %v, %v", order, err)
+	}
+}
+
+func TestLayers(t *testing.T) {
+	layers, err := Layers(build)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := [][]string{
+		{"codegen", "fetch"},
+		{"compile", "lint"},
+		{"test"},
+		{"package"},
+		{"publish"},
+	}
+	if !slices.EqualFunc(layers, expected, slices.Equal) {
+		t.Errorf("Expected %v, got %v", expected, layers)
+	}
+}
+
+func TestLayersCycle(t *testing.T) {
+	_, err := Layers(cyclic)
+	checkCycle(t, cyclic, err)
+}
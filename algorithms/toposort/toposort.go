@@ -0,0 +1,94 @@
+// Package toposort orders the vertices of a directed acyclic graph so that every edge
+// points forward, and reports a concrete cycle when no such order exists.
+//
+// Graphs are plain adjacency maps: graph[u] lists the vertices v with an edge u -> v,
+// meaning u must come before v. Vertices that only appear as edge targets are included.
+// Vertices are started from in ascending order and edges are followed in slice order,
+// so every function returns the same result for the same input.
+package toposort
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/codeYann/go-collections/algorithms/internal/adjmap"
+	"github.com/codeYann/go-collections/stack"
+)
+
+// CycleError is returned when the graph contains a cycle and therefore has no topological order.
+type CycleError[V comparable] struct {
+	// Cycle lists the vertices of one cycle in edge order; the last vertex has an edge back to the first.
+	Cycle []V
+}
+
+func (e *CycleError[V]) Error() string {
+	return fmt.Sprintf("graph contains a cycle: %v", e.Cycle)
+}
+
+// FindCycle returns one cycle of the graph in edge order, or false if the graph is acyclic.
+func FindCycle[V cmp.Ordered](graph map[V][]V) ([]V, bool) {
+	_, cycle := dfs(graph, adjmap.Vertices(graph))
+	return cycle, cycle != nil
+}
+
+const (
+	unvisited = iota
+	onPath
+	finished
+)
+
+// dfs runs an iterative depth-first search from each of roots in turn and returns the vertices
+// in postorder. roots must list every vertex of the graph. The search stops at the first edge
+// leading back to a vertex on the current path and returns the cycle that edge closes instead.
+func dfs[V comparable](graph map[V][]V, roots []V) ([]V, []V) {
+	state := make(map[V]int, len(roots))
+	parent := make(map[V]V)
+	postorder := make([]V, 0, len(roots))
+
+	// A vertex is only pushed while unvisited, so the stack never holds more than len(roots) frames.
+	s := stack.NewStack[*adjmap.Frame[V]](uint(len(roots)))
+
+	for _, root := range roots {
+		if state[root] != unvisited {
+			continue
+		}
+		state[root] = onPath
+		s.Push(&adjmap.Frame[V]{Vertex: root})
+
+		for !s.IsEmpty() {
+			top, _ := s.Peek()
+			edges := graph[top.Vertex]
+
+			if top.Next == len(edges) {
+				s.Pop()
+				state[top.Vertex] = finished
+				postorder = append(postorder, top.Vertex)
+				continue
+			}
+
+			to := edges[top.Next]
+			top.Next++
+			switch state[to] {
+			case onPath:
+				return nil, closeCycle(parent, top.Vertex, to)
+			case unvisited:
+				state[to] = onPath
+				parent[to] = top.Vertex
+				s.Push(&adjmap.Frame[V]{Vertex: to})
+			}
+		}
+	}
+	return postorder, nil
+}
+
+// closeCycle rebuilds the cycle formed by the path from to down to from and the edge from -> to.
+func closeCycle[V comparable](parent map[V]V, from, to V) []V {
+	cycle := []V{from}
+	for v := from; v != to; {
+		v = parent[v]
+		cycle = append(cycle, v)
+	}
+	slices.Reverse(cycle)
+	return cycle
+}
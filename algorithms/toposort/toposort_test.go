@@ -0,0 +1,89 @@
+package toposort
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/codeYann/go-collections/algorithms/internal/adjmap"
+)
+
+// checkOrder verifies that order lists every vertex of graph once and that every edge points forward.
+func checkOrder(t *testing.T, graph map[string][]string, order []string) {
+	t.Helper()
+	position := make(map[string]int, len(order))
+	for i, v := range order {
+		if _, dup := position[v]; dup {
+			t.Fatalf("Vertex %s appears twice in %v", v, order)
+		}
+		position[v] = i
+	}
+	if want := adjmap.Vertices(graph); len(order) != len(want) {
+		t.Fatalf("Expected %d vertices, got %v", len(want), order)
+	}
+	for u, edges := range graph {
+		for _, v := range edges {
+			if position[u] >= position[v] {
+				t.Errorf("Edge %s -> %s points backward in %v", u, v, order)
+			}
+		}
+	}
+}
+
+// checkCycle verifies that err is a *CycleError whose cycle is made of edges of graph.
+func checkCycle(t *testing.T, graph map[string][]string, err error) {
+	t.Helper()
+	var cycleErr *CycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Expected CycleError, got %v", err)
+	}
+	cycle := cycleErr.Cycle
+	if len(cycle) == 0 {
+		t.Fatal("Expected a non-empty cycle")
+	}
+	for i, u := range cycle {
+		v := cycle[(i+1)%len(cycle)]
+		if !slices.Contains(graph[u], v) {
+			t.Errorf("Cycle %v uses a missing edge %s -> %s", cycle, u, v)
+		}
+	}
+}
+
+var build = map[string][]string{
+	"fetch":   {"compile", "lint"},
+	"codegen": {"compile"},
+	"compile": {"test", "package"},
+	"lint":    {"package"},
+	"test":    {"package"},
+	"package": {"publish"},
+}
+
+var cyclic = map[string][]string{
+	"a": {"b"},
+	"b": {"c"},
+	"c": {"d", "e"},
+	"d": {"b"},
+	"e": nil,
+}
+
+func TestFindCycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		graph map[string][]string
+		want  []string
+	}{
+		{"acyclic", build, nil},
+		{"empty", map[string][]string{}, nil},
+		{"self loop", map[string][]string{"a": {"a"}}, []string{"a"}},
+		{"three cycle", cyclic, []string{"b", "c", "d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cycle, ok := FindCycle(tt.graph)
+			if ok != (tt.want != nil) || !slices.Equal(cycle, tt.want) {
+				t.Errorf("FindCycle() = %v, %v; expected %v", cycle, ok, tt.want)
+			}
+		})
+	}
+}
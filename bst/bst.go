@@ -1,5 +1,11 @@
 package bst
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 type Node[T any] struct {
 	Val    T
 	Left   *Node[T]
@@ -9,25 +15,98 @@ type Node[T any] struct {
 
 type Comparator[T any] func(a, b T) int
 
+type DuplicatePolicy int
+
+const (
+	AllowDuplicates DuplicatePolicy = iota
+	RejectDuplicates
+	ReplaceDuplicates
+)
+
+var (
+	ErrDuplicate = errors.New("element already exists")
+	ErrNotSorted = errors.New("input is not sorted")
+)
+
+type Option func(*options)
+
+type options struct {
+	duplicates DuplicatePolicy
+}
+
+func WithDuplicates(policy DuplicatePolicy) Option {
+	return func(o *options) {
+		o.duplicates = policy
+	}
+}
+
 type Tree[T any] struct {
 	Root       *Node[T]
 	Comparator Comparator[T]
+	Duplicates DuplicatePolicy
 }
 
 func NewNode[T any](val T) *Node[T] {
 	return &Node[T]{Val: val}
 }
 
-func NewTree[T any]() *Tree[T] {
-	return &Tree[T]{Root: nil}
+func NewTree[T any](opts ...Option) *Tree[T] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &Tree[T]{Root: nil, Duplicates: o.duplicates}
 }
 
-func (t *Tree[T]) Insert(elem T) {
+func FromSorted[T any](values []T, cmp Comparator[T], opts ...Option) (*Tree[T], error) {
+	tree := NewTree[T](opts...)
+	tree.Comparator = cmp
+
+	kept := make([]T, 0, len(values))
+	for i, v := range values {
+		if i == 0 {
+			kept = append(kept, v)
+			continue
+		}
+
+		c := cmp(values[i-1], v)
+		if c > 0 {
+			return nil, ErrNotSorted
+		}
+		if c == 0 && tree.Duplicates == RejectDuplicates {
+			return nil, ErrDuplicate
+		}
+		if c == 0 && tree.Duplicates == ReplaceDuplicates {
+			kept[len(kept)-1] = v
+			continue
+		}
+		kept = append(kept, v)
+	}
+
+	tree.Root = build(kept, nil)
+	return tree, nil
+}
+
+func build[T any](values []T, parent *Node[T]) *Node[T] {
+	if len(values) == 0 {
+		return nil
+	}
+
+	mid := len(values) / 2
+	node := NewNode(values[mid])
+	node.Parent = parent
+	node.Left = build(values[:mid], node)
+	node.Right = build(values[mid+1:], node)
+	return node
+}
+
+func (t *Tree[T]) Insert(elem T) error {
 	newNode := NewNode(elem)
 
 	if t.Root == nil {
 		t.Root = newNode
-		return
+		return nil
 	}
 
 	current := t.Root
@@ -35,18 +114,26 @@ func (t *Tree[T]) Insert(elem T) {
 	for {
 		cmp := t.Comparator(elem, current.Val)
 
+		if cmp == 0 && t.Duplicates == RejectDuplicates {
+			return ErrDuplicate
+		}
+		if cmp == 0 && t.Duplicates == ReplaceDuplicates {
+			current.Val = elem
+			return nil
+		}
+
 		if cmp < 0 {
 			if current.Left == nil {
 				current.Left = newNode
 				newNode.Parent = current
-				return
+				return nil
 			}
 			current = current.Left
 		} else {
 			if current.Right == nil {
 				current.Right = newNode
 				newNode.Parent = current
-				return
+				return nil
 			}
 			current = current.Right
 		}
@@ -73,6 +160,70 @@ func (t *Tree[T]) Search(elem T) *Node[T] {
 	return nil
 }
 
+func (t *Tree[T]) Floor(elem T) (*Node[T], bool) {
+	var result *Node[T]
+	current := t.Root
+
+	for current != nil {
+		if t.Comparator(elem, current.Val) >= 0 {
+			result = current
+			current = current.Right
+		} else {
+			current = current.Left
+		}
+	}
+
+	return result, result != nil
+}
+
+func (t *Tree[T]) Ceiling(elem T) (*Node[T], bool) {
+	var result *Node[T]
+	current := t.Root
+
+	for current != nil {
+		if t.Comparator(elem, current.Val) <= 0 {
+			result = current
+			current = current.Left
+		} else {
+			current = current.Right
+		}
+	}
+
+	return result, result != nil
+}
+
+func (t *Tree[T]) Lower(elem T) (*Node[T], bool) {
+	var result *Node[T]
+	current := t.Root
+
+	for current != nil {
+		if t.Comparator(elem, current.Val) > 0 {
+			result = current
+			current = current.Right
+		} else {
+			current = current.Left
+		}
+	}
+
+	return result, result != nil
+}
+
+func (t *Tree[T]) Higher(elem T) (*Node[T], bool) {
+	var result *Node[T]
+	current := t.Root
+
+	for current != nil {
+		if t.Comparator(elem, current.Val) < 0 {
+			result = current
+			current = current.Left
+		} else {
+			current = current.Right
+		}
+	}
+
+	return result, result != nil
+}
+
 func (t *Tree[T]) Minimum(node *Node[T]) *Node[T] {
 	current := node
 	for current.Left != nil {
@@ -175,3 +326,79 @@ func (t *Tree[T]) Remove(elem T) {
 	sucessor.Left = node.Left
 	sucessor.Left.Parent = sucessor
 }
+
+func (t *Tree[T]) Count(elem T) int {
+	return t.count(t.Root, elem)
+}
+
+func (t *Tree[T]) count(node *Node[T], elem T) int {
+	if node == nil {
+		return 0
+	}
+
+	cmp := t.Comparator(elem, node.Val)
+	if cmp < 0 {
+		return t.count(node.Left, elem)
+	}
+	if cmp > 0 {
+		return t.count(node.Right, elem)
+	}
+	return 1 + t.count(node.Left, elem) + t.count(node.Right, elem)
+}
+
+func (t *Tree[T]) RemoveAll(elem T) int {
+	removed := 0
+	for t.Search(elem) != nil {
+		t.Remove(elem)
+		removed++
+	}
+	return removed
+}
+
+func (t *Tree[T]) Validate() error {
+	if t.Root == nil {
+		return nil
+	}
+	if t.Root.Parent != nil {
+		return fmt.Errorf("root has a parent")
+	}
+
+	var prev *Node[T]
+	path := []string{"root"}
+
+	var check func(node *Node[T]) error
+	check = func(node *Node[T]) error {
+		if node == nil {
+			return nil
+		}
+
+		at := strings.Join(path, ".")
+		if node.Left != nil && node.Left.Parent != node {
+			return fmt.Errorf("node at %s: left child's Parent does not point back to it", at)
+		}
+		if node.Right != nil && node.Right.Parent != node {
+			return fmt.Errorf("node at %s: right child's Parent does not point back to it", at)
+		}
+
+		path = append(path, "L")
+		if err := check(node.Left); err != nil {
+			return err
+		}
+		path = path[:len(path)-1]
+
+		if prev != nil && t.Comparator(prev.Val, node.Val) > 0 {
+			return fmt.Errorf("node at %s: value is out of order with its in-order predecessor", at)
+		}
+		prev = node
+
+		path = append(path, "R")
+		if err := check(node.Right); err != nil {
+			return err
+		}
+		path = path[:len(path)-1]
+
+		return nil
+	}
+
+	return check(t.Root)
+}
@@ -1,6 +1,8 @@
 package bst
 
 import (
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -196,3 +198,247 @@ func TestRemove(t *testing.T) {
 		t.Errorf("Expected parent of node 7 to be 10, got %v", tree.Root.Left.Parent)
 	}
 }
+
+func TestFloorCeilingLowerHigher(t *testing.T) {
+	cmp := func(a, b int) int {
+		return a - b
+	}
+	tree := &Tree[int]{Comparator: cmp}
+	tree.Insert(10)
+	tree.Insert(5)
+	tree.Insert(15)
+	tree.Insert(3)
+	tree.Insert(7)
+
+	tests := []struct {
+		name   string
+		query  func(int) (*Node[int], bool)
+		elem   int
+		want   int
+		wantOk bool
+	}{
+		{name: "floor exact", query: tree.Floor, elem: 7, want: 7, wantOk: true},
+		{name: "floor between", query: tree.Floor, elem: 9, want: 7, wantOk: true},
+		{name: "floor below minimum", query: tree.Floor, elem: 2, wantOk: false},
+		{name: "ceiling exact", query: tree.Ceiling, elem: 7, want: 7, wantOk: true},
+		{name: "ceiling between", query: tree.Ceiling, elem: 8, want: 10, wantOk: true},
+		{name: "ceiling above maximum", query: tree.Ceiling, elem: 16, wantOk: false},
+		{name: "lower exact", query: tree.Lower, elem: 10, want: 7, wantOk: true},
+		{name: "lower minimum", query: tree.Lower, elem: 3, wantOk: false},
+		{name: "higher exact", query: tree.Higher, elem: 10, want: 15, wantOk: true},
+		{name: "higher maximum", query: tree.Higher, elem: 15, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, ok := tt.query(tt.elem)
+			if ok != tt.wantOk {
+				t.Fatalf("Expected ok=%v, got %v", tt.wantOk, ok)
+			}
+			if !ok && node != nil {
+				t.Errorf("Expected nil node when not found, got %v", node)
+			}
+			if ok && node.Val != tt.want {
+				t.Errorf("Expected %d, got %d", tt.want, node.Val)
+			}
+		})
+	}
+}
+
+func TestDuplicatePolicies(t *testing.T) {
+	type record struct {
+		key  int
+		name string
+	}
+	cmp := func(a, b record) int {
+		return a.key - b.key
+	}
+
+	multiset := NewTree[record]()
+	multiset.Comparator = cmp
+	for _, name := range []string{"a", "b", "c"} {
+		if err := multiset.Insert(record{key: 1, name: name}); err != nil {
+			t.Errorf("Unexpected error inserting duplicate into multiset: %v", err)
+		}
+	}
+	multiset.Insert(record{key: 0})
+	multiset.Insert(record{key: 2})
+	if size := multiset.Size(multiset.Root); size != 5 {
+		t.Errorf("Expected multiset size 5, got %d", size)
+	}
+	if count := multiset.Count(record{key: 1}); count != 3 {
+		t.Errorf("Expected Count 3, got %d", count)
+	}
+
+	reject := NewTree[record](WithDuplicates(RejectDuplicates))
+	reject.Comparator = cmp
+	reject.Insert(record{key: 1, name: "first"})
+	if err := reject.Insert(record{key: 1, name: "second"}); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("Expected ErrDuplicate, got %v", err)
+	}
+	if node := reject.Search(record{key: 1}); node.Val.name != "first" {
+		t.Errorf("Expected rejected insert to keep original value, got %q", node.Val.name)
+	}
+
+	replace := NewTree[record](WithDuplicates(ReplaceDuplicates))
+	replace.Comparator = cmp
+	replace.Insert(record{key: 1, name: "first"})
+	if err := replace.Insert(record{key: 1, name: "second"}); err != nil {
+		t.Errorf("Unexpected error replacing value: %v", err)
+	}
+	if size := replace.Size(replace.Root); size != 1 {
+		t.Errorf("Expected size 1 after replace, got %d", size)
+	}
+	if node := replace.Search(record{key: 1}); node.Val.name != "second" {
+		t.Errorf("Expected replaced value \"second\", got %q", node.Val.name)
+	}
+}
+
+func TestRemoveAll(t *testing.T) {
+	cmp := func(a, b int) int {
+		return a - b
+	}
+	tree := &Tree[int]{Comparator: cmp}
+	for _, v := range []int{5, 3, 5, 8, 5, 1, 5} {
+		tree.Insert(v)
+	}
+
+	if removed := tree.RemoveAll(5); removed != 4 {
+		t.Errorf("Expected RemoveAll to remove 4 elements, got %d", removed)
+	}
+	if count := tree.Count(5); count != 0 {
+		t.Errorf("Expected Count 0 after RemoveAll, got %d", count)
+	}
+	if size := tree.Size(tree.Root); size != 3 {
+		t.Errorf("Expected size 3 after RemoveAll, got %d", size)
+	}
+	if removed := tree.RemoveAll(42); removed != 0 {
+		t.Errorf("Expected RemoveAll of missing element to remove 0, got %d", removed)
+	}
+}
+
+func TestFromSorted(t *testing.T) {
+	cmp := func(a, b int) int {
+		return a - b
+	}
+
+	for n := 0; n <= 64; n++ {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = i
+		}
+
+		tree, err := FromSorted(values, cmp)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error: %v", n, err)
+		}
+		if size := tree.Size(tree.Root); size != n {
+			t.Errorf("n=%d: expected size %d, got %d", n, n, size)
+		}
+
+		// A perfectly balanced tree of n nodes has height floor(log2(n)).
+		expectedHeight := -1
+		for m := n; m > 0; m /= 2 {
+			expectedHeight++
+		}
+		if height := tree.Height(tree.Root); height != expectedHeight {
+			t.Errorf("n=%d: expected height %d, got %d", n, expectedHeight, height)
+		}
+
+		for _, v := range values {
+			node := tree.Search(v)
+			if node == nil {
+				t.Fatalf("n=%d: expected to find %d", n, v)
+			}
+			if node != tree.Root && node.Parent.Left != node && node.Parent.Right != node {
+				t.Errorf("n=%d: inconsistent parent pointer for %d", n, v)
+			}
+		}
+	}
+}
+
+func TestFromSortedErrors(t *testing.T) {
+	cmp := func(a, b int) int {
+		return a - b
+	}
+
+	if _, err := FromSorted([]int{1, 3, 2}, cmp); !errors.Is(err, ErrNotSorted) {
+		t.Errorf("Expected ErrNotSorted, got %v", err)
+	}
+	if _, err := FromSorted([]int{1, 2, 2}, cmp, WithDuplicates(RejectDuplicates)); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("Expected ErrDuplicate, got %v", err)
+	}
+
+	tree, err := FromSorted([]int{1, 2, 2, 3}, cmp, WithDuplicates(ReplaceDuplicates))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if size := tree.Size(tree.Root); size != 3 {
+		t.Errorf("Expected size 3 after collapsing duplicates, got %d", size)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cmp := func(a, b int) int {
+		return a - b
+	}
+
+	build := func() *Tree[int] {
+		tree := &Tree[int]{Comparator: cmp}
+		for _, v := range []int{10, 5, 15, 3, 7, 12, 20} {
+			tree.Insert(v)
+		}
+		return tree
+	}
+
+	if err := (&Tree[int]{Comparator: cmp}).Validate(); err != nil {
+		t.Errorf("Expected empty tree to be valid, got %v", err)
+	}
+
+	tree := build()
+	if err := tree.Validate(); err != nil {
+		t.Fatalf("Expected valid tree, got %v", err)
+	}
+	tree.Remove(5)
+	tree.Remove(10)
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Expected valid tree after removals, got %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		corrupt func(tree *Tree[int])
+		want    string
+	}{
+		{
+			name:    "root with parent",
+			corrupt: func(tree *Tree[int]) { tree.Root.Parent = tree.Root.Left },
+			want:    "root has a parent",
+		},
+		{
+			name:    "broken parent link",
+			corrupt: func(tree *Tree[int]) { tree.Root.Left.Right.Parent = tree.Root },
+			want:    "node at root.L: right child's Parent",
+		},
+		{
+			name:    "out of order value",
+			corrupt: func(tree *Tree[int]) { tree.Root.Right.Left.Val = 9 },
+			want:    "node at root.R.L: value is out of order",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := build()
+			tt.corrupt(tree)
+
+			err := tree.Validate()
+			if err == nil {
+				t.Fatal("Expected Validate to report a violation")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %q", tt.want, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Package graph implements a generic adjacency-list graph with breadth-first and
+// depth-first traversals built on the queue and stack packages.
+package graph
+
+import (
+	"errors"
+	"iter"
+	"slices"
+)
+
+// Edge is an outgoing edge stored in a vertex's adjacency list.
+type Edge[V comparable, W any] struct {
+	To     V
+	Weight W
+}
+
+// Graph is a directed or undirected graph with vertices of type V and edge weights of type W.
+// Vertices and each vertex's edges are kept in insertion order, so traversals are deterministic.
+// In an undirected graph every edge is stored in both endpoints' adjacency lists.
+type Graph[V comparable, W any] struct {
+	directed bool
+	vertices []V
+	adj      map[V][]Edge[V, W]
+	edges    int
+}
+
+// NewDirected creates and returns an empty directed graph.
+func NewDirected[V comparable, W any]() *Graph[V, W] {
+	return &Graph[V, W]{directed: true, adj: make(map[V][]Edge[V, W])}
+}
+
+// NewUndirected creates and returns an empty undirected graph.
+func NewUndirected[V comparable, W any]() *Graph[V, W] {
+	return &Graph[V, W]{directed: false, adj: make(map[V][]Edge[V, W])}
+}
+
+// IsDirected reports whether the graph is directed.
+func (g *Graph[V, W]) IsDirected() bool {
+	return g.directed
+}
+
+// VertexCount returns the number of vertices in the graph.
+func (g *Graph[V, W]) VertexCount() int {
+	return len(g.vertices)
+}
+
+// EdgeCount returns the number of edges in the graph. An undirected edge counts once.
+func (g *Graph[V, W]) EdgeCount() int {
+	return g.edges
+}
+
+// HasVertex reports whether v is a vertex of the graph.
+func (g *Graph[V, W]) HasVertex(v V) bool {
+	_, ok := g.adj[v]
+	return ok
+}
+
+// AddVertex adds v to the graph and reports whether it was not already present.
+func (g *Graph[V, W]) AddVertex(v V) bool {
+	if g.HasVertex(v) {
+		return false
+	}
+	g.vertices = append(g.vertices, v)
+	g.adj[v] = nil
+	return true
+}
+
+// setEdge points from at to with weight w, replacing an existing edge. It reports whether the edge is new.
+func (g *Graph[V, W]) setEdge(from, to V, w W) bool {
+	edges := g.adj[from]
+	for i := range edges {
+		if edges[i].To == to {
+			edges[i].Weight = w
+			return false
+		}
+	}
+	g.adj[from] = append(edges, Edge[V, W]{To: to, Weight: w})
+	return true
+}
+
+// AddEdge adds an edge from one vertex to another with the given weight, adding missing vertices.
+// If the edge already exists its weight is replaced. Undirected edges are added in both directions.
+func (g *Graph[V, W]) AddEdge(from, to V, w W) {
+	g.AddVertex(from)
+	g.AddVertex(to)
+
+	added := g.setEdge(from, to, w)
+	if !g.directed && from != to {
+		g.setEdge(to, from, w)
+	}
+	if added {
+		g.edges++
+	}
+}
+
+// deleteEdge removes the edge from one vertex to another and reports whether it existed.
+func (g *Graph[V, W]) deleteEdge(from, to V) bool {
+	edges := g.adj[from]
+	for i := range edges {
+		if edges[i].To == to {
+			g.adj[from] = slices.Delete(edges, i, i+1)
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveEdge deletes the edge from one vertex to another.
+// It returns an error if the edge does not exist.
+func (g *Graph[V, W]) RemoveEdge(from, to V) error {
+	if !g.deleteEdge(from, to) {
+		return errors.New("edge is not in the graph")
+	}
+	if !g.directed && from != to {
+		g.deleteEdge(to, from)
+	}
+	g.edges--
+	return nil
+}
+
+// HasEdge reports whether there is an edge from one vertex to another.
+func (g *Graph[V, W]) HasEdge(from, to V) bool {
+	_, ok := g.Weight(from, to)
+	return ok
+}
+
+// Weight returns the weight of the edge from one vertex to another and whether the edge exists.
+func (g *Graph[V, W]) Weight(from, to V) (W, bool) {
+	for _, e := range g.adj[from] {
+		if e.To == to {
+			return e.Weight, true
+		}
+	}
+	var zero W
+	return zero, false
+}
+
+// Vertices returns an iterator over the graph's vertices in insertion order.
+func (g *Graph[V, W]) Vertices() iter.Seq[V] {
+	return slices.Values(g.vertices)
+}
+
+// Neighbors returns an iterator over the vertices adjacent to v and the weights of the edges reaching them.
+// Its signature matches the adjacency callbacks taken by the algorithms packages.
+func (g *Graph[V, W]) Neighbors(v V) iter.Seq2[V, W] {
+	return func(yield func(V, W) bool) {
+		for _, e := range g.adj[v] {
+			if !yield(e.To, e.Weight) {
+				return
+			}
+		}
+	}
+}
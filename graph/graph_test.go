@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestDirectedGraph(t *testing.T) {
+	g := NewDirected[string, int]()
+
+	if !g.AddVertex("a") || g.AddVertex("a") {
+		t.Error("Expected AddVertex to report whether the vertex was new")
+	}
+
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "c", 2)
+	g.AddEdge("b", "c", 3)
+
+	if !g.IsDirected() {
+		t.Error("Expected graph to be directed")
+	}
+	if g.VertexCount() != 3 || g.EdgeCount() != 3 {
+		t.Errorf("Expected 3 vertices and 3 edges, got %d and %d", g.VertexCount(), g.EdgeCount())
+	}
+	if !g.HasEdge("a", "b") || g.HasEdge("b", "a") {
+		t.Error("Expected directed edge a -> b only")
+	}
+
+	neighbors := maps.Collect(g.Neighbors("a"))
+	if len(neighbors) != 2 || neighbors["b"] != 1 || neighbors["c"] != 2 {
+		t.Errorf("Unexpected neighbors of a: %v", neighbors)
+	}
+
+	g.AddEdge("a", "b", 10)
+	if w, _ := g.Weight("a", "b"); w != 10 {
+		t.Errorf("Expected AddEdge to replace the weight, got %d", w)
+	}
+	if g.EdgeCount() != 3 {
+		t.Errorf("Expected replacing a weight to keep 3 edges, got %d", g.EdgeCount())
+	}
+
+	if err := g.RemoveEdge("a", "b"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := g.RemoveEdge("a", "b"); err == nil {
+		t.Error("Expected error removing a missing edge")
+	}
+	if g.HasEdge("a", "b") || g.EdgeCount() != 2 {
+		t.Error("Expected edge a -> b to be removed")
+	}
+	if g.VertexCount() != 3 {
+		t.Error("Removing an edge must keep its vertices")
+	}
+}
+
+func TestUndirectedGraph(t *testing.T) {
+	g := NewUndirected[int, float64]()
+	g.AddEdge(1, 2, 0.5)
+	g.AddEdge(2, 3, 1.5)
+	g.AddEdge(3, 3, 2)
+
+	if g.IsDirected() {
+		t.Error("Expected graph to be undirected")
+	}
+	if g.EdgeCount() != 3 {
+		t.Errorf("Expected 3 edges, got %d", g.EdgeCount())
+	}
+	if w, ok := g.Weight(2, 1); !ok || w != 0.5 {
+		t.Errorf("Expected edge 2 - 1 with weight 0.5, got %v, %v", w, ok)
+	}
+
+	if got := slices.Collect(maps.Keys(maps.Collect(g.Neighbors(3)))); len(got) != 2 {
+		t.Errorf("Expected 3 to have neighbors 2 and itself, got %v", got)
+	}
+
+	g.RemoveEdge(2, 1)
+	if g.HasEdge(1, 2) || g.HasEdge(2, 1) {
+		t.Error("Expected undirected edge to be removed in both directions")
+	}
+
+	if got := slices.Collect(g.Vertices()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Expected vertices in insertion order, got %v", got)
+	}
+}
@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"iter"
+
+	"github.com/codeYann/go-collections/queue"
+	"github.com/codeYann/go-collections/stack"
+)
+
+// Visitor holds optional callbacks invoked while a traversal runs.
+// Vertices are visited by ranging over the iterator returned by BFS or DFS.
+type Visitor[V any] struct {
+	// Discover is called when to is reached for the first time through the edge from -> to.
+	// It is not called for the start vertex.
+	Discover func(from, to V)
+	// Finish is called by DFS once every vertex reachable from v has been explored.
+	// BFS never calls it.
+	Finish func(v V)
+}
+
+// BFS returns an iterator over the vertices reachable from start in breadth-first order.
+// Each vertex is visited once. Iteration stops early if the consumer breaks out of the loop.
+func (g *Graph[V, W]) BFS(start V, visitor Visitor[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if !g.HasVertex(start) {
+			return
+		}
+
+		// Every vertex is enqueued at most once; Queue keeps one slot free.
+		q, _ := queue.NewQueue[V](uint(len(g.vertices) + 1))
+		discovered := map[V]bool{start: true}
+		q.Enqueue(start)
+
+		for !q.IsEmpty() {
+			v, _ := q.Dequeue()
+			if !yield(v) {
+				return
+			}
+
+			for _, e := range g.adj[v] {
+				if discovered[e.To] {
+					continue
+				}
+				discovered[e.To] = true
+				if visitor.Discover != nil {
+					visitor.Discover(v, e.To)
+				}
+				q.Enqueue(e.To)
+			}
+		}
+	}
+}
+
+// frame is a vertex on the DFS stack together with the index of the next edge to explore.
+type frame[V any] struct {
+	v    V
+	next int
+}
+
+// DFS returns an iterator over the vertices reachable from start in depth-first preorder,
+// exploring edges in insertion order as a recursive traversal would. It uses an explicit
+// stack, so deep graphs cannot overflow the goroutine stack. Each vertex is visited once.
+// Iteration stops early if the consumer breaks out of the loop.
+func (g *Graph[V, W]) DFS(start V, visitor Visitor[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if !g.HasVertex(start) {
+			return
+		}
+
+		// Every vertex is pushed at most once.
+		s := stack.NewStack[*frame[V]](uint(len(g.vertices)))
+		discovered := map[V]bool{start: true}
+		s.Push(&frame[V]{v: start})
+		if !yield(start) {
+			return
+		}
+
+		for !s.IsEmpty() {
+			top, _ := s.Peek()
+			edges := g.adj[top.v]
+
+			for top.next < len(edges) && discovered[edges[top.next].To] {
+				top.next++
+			}
+			if top.next == len(edges) {
+				s.Pop()
+				if visitor.Finish != nil {
+					visitor.Finish(top.v)
+				}
+				continue
+			}
+
+			to := edges[top.next].To
+			top.next++
+			discovered[to] = true
+			if visitor.Discover != nil {
+				visitor.Discover(top.v, to)
+			}
+			s.Push(&frame[V]{v: to})
+			if !yield(to) {
+				return
+			}
+		}
+	}
+}
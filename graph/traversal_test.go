@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+/*
+1 -> 2 -> 4
+|    |
+v    v
+3 -> 5    6 (unreachable)
+*/
+func newTraversalGraph() *Graph[int, struct{}] {
+	g := NewDirected[int, struct{}]()
+	g.AddEdge(1, 2, struct{}{})
+	g.AddEdge(1, 3, struct{}{})
+	g.AddEdge(2, 4, struct{}{})
+	g.AddEdge(2, 5, struct{}{})
+	g.AddEdge(3, 5, struct{}{})
+	g.AddVertex(6)
+	return g
+}
+
+func TestBFS(t *testing.T) {
+	g := newTraversalGraph()
+
+	var edges [][2]int
+	order := slices.Collect(g.BFS(1, Visitor[int]{
+		Discover: func(from, to int) { edges = append(edges, [2]int{from, to}) },
+	}))
+
+	if !slices.Equal(order, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("BFS order = %v, expected [1 2 3 4 5]", order)
+	}
+	expectedEdges := [][2]int{{1, 2}, {1, 3}, {2, 4}, {2, 5}}
+	if !slices.Equal(edges, expectedEdges) {
+		t.Errorf("Discovered edges = %v, expected %v", edges, expectedEdges)
+	}
+
+	if got := slices.Collect(g.BFS(6, Visitor[int]{})); !slices.Equal(got, []int{6}) {
+		t.Errorf("Expected isolated vertex to visit only itself, got %v", got)
+	}
+	if got := slices.Collect(g.BFS(42, Visitor[int]{})); len(got) != 0 {
+		t.Errorf("Expected no vertices from a missing start, got %v", got)
+	}
+}
+
+func TestDFS(t *testing.T) {
+	g := newTraversalGraph()
+
+	var edges [][2]int
+	var finished []int
+	order := slices.Collect(g.DFS(1, Visitor[int]{
+		Discover: func(from, to int) { edges = append(edges, [2]int{from, to}) },
+		Finish:   func(v int) { finished = append(finished, v) },
+	}))
+
+	if !slices.Equal(order, []int{1, 2, 4, 5, 3}) {
+		t.Errorf("DFS order = %v, expected [1 2 4 5 3]", order)
+	}
+	if !slices.Equal(finished, []int{4, 5, 2, 3, 1}) {
+		t.Errorf("Finish order = %v, expected [4 5 2 3 1]", finished)
+	}
+	expectedEdges := [][2]int{{1, 2}, {2, 4}, {2, 5}, {1, 3}}
+	if !slices.Equal(edges, expectedEdges) {
+		t.Errorf("Discovered edges = %v, expected %v", edges, expectedEdges)
+	}
+}
+
+func TestTraversalStopsEarly(t *testing.T) {
+	g := newTraversalGraph()
+
+	for name, seq := range map[string]func() []int{
+		"BFS": func() []int {
+			var got []int
+			for v := range g.BFS(1, Visitor[int]{}) {
+				got = append(got, v)
+				if len(got) == 2 {
+					break
+				}
+			}
+			return got
+		},
+		"DFS": func() []int {
+			var got []int
+			for v := range g.DFS(1, Visitor[int]{}) {
+				got = append(got, v)
+				if len(got) == 2 {
+					break
+				}
+			}
+			return got
+		},
+	} {
+		if got := seq(); !slices.Equal(got, []int{1, 2}) {
+			t.Errorf("%s: expected [1 2] before break, got %v", name, got)
+		}
+	}
+}
+
+func TestTraversalUndirectedCycle(t *testing.T) {
+	g := NewUndirected[string, int]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("c", "a", 1)
+
+	if got := slices.Collect(g.BFS("a", Visitor[string]{})); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("BFS order = %v", got)
+	}
+	if got := slices.Collect(g.DFS("a", Visitor[string]{})); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("DFS order = %v", got)
+	}
+}
+
+func TestDFSDeepPath(t *testing.T) {
+	const n = 200000
+	g := NewDirected[int, struct{}]()
+	for i := 0; i < n-1; i++ {
+		g.AddEdge(i, i+1, struct{}{})
+	}
+
+	count := 0
+	for range g.DFS(0, Visitor[int]{}) {
+		count++
+	}
+	if count != n {
+		t.Errorf("Expected to visit %d vertices, got %d", n, count)
+	}
+}
@@ -0,0 +1,109 @@
+package heap
+
+import "errors"
+
+// indexedEntry pairs an id with its priority inside an IndexedPriorityQueue.
+type indexedEntry[K comparable, P any] struct {
+	id   K
+	prio P
+}
+
+// IndexedPriorityQueue is a min-heap of unique ids ordered by their priorities.
+// An id's priority can be lowered in place with DecreaseKey, which is what
+// Dijkstra's and Prim's algorithms need. All updates run in O(log n).
+type IndexedPriorityQueue[K comparable, P any] struct {
+	pq      *PriorityQueue[indexedEntry[K, P]]
+	handles map[K]*Item[indexedEntry[K, P]]
+	cmp     Comparator[P]
+}
+
+// NewIndexedPriorityQueue creates and returns an empty indexed priority queue whose priorities
+// are ordered by the specified comparator function.
+func NewIndexedPriorityQueue[K comparable, P any](cmp Comparator[P]) *IndexedPriorityQueue[K, P] {
+	return &IndexedPriorityQueue[K, P]{
+		pq: NewPriorityQueue(func(a, b indexedEntry[K, P]) int {
+			return cmp(a.prio, b.prio)
+		}),
+		handles: make(map[K]*Item[indexedEntry[K, P]]),
+		cmp:     cmp,
+	}
+}
+
+// Len returns the number of ids in the queue.
+func (q *IndexedPriorityQueue[K, P]) Len() int {
+	return q.pq.Len()
+}
+
+// IsEmpty reports whether the queue has no ids.
+func (q *IndexedPriorityQueue[K, P]) IsEmpty() bool {
+	return q.pq.IsEmpty()
+}
+
+// Contains reports whether id is in the queue.
+func (q *IndexedPriorityQueue[K, P]) Contains(id K) bool {
+	_, ok := q.handles[id]
+	return ok
+}
+
+// Priority returns the current priority of id and whether id is in the queue.
+func (q *IndexedPriorityQueue[K, P]) Priority(id K) (P, bool) {
+	item, ok := q.handles[id]
+	if !ok {
+		var zero P
+		return zero, false
+	}
+	return item.Value.prio, true
+}
+
+// Insert adds id with the given priority. It fails if id is already in the queue.
+func (q *IndexedPriorityQueue[K, P]) Insert(id K, prio P) error {
+	if q.Contains(id) {
+		return errors.New("id is already in the priority queue")
+	}
+	q.handles[id] = q.pq.Push(indexedEntry[K, P]{id: id, prio: prio})
+	return nil
+}
+
+// DecreaseKey lowers the priority of id to prio. It fails if id is not in the queue
+// or if prio is greater than the current priority.
+func (q *IndexedPriorityQueue[K, P]) DecreaseKey(id K, prio P) error {
+	item, ok := q.handles[id]
+	if !ok {
+		return errors.New("id is not in the priority queue")
+	}
+	if q.cmp(prio, item.Value.prio) > 0 {
+		return errors.New("new priority is greater than the current priority")
+	}
+
+	item.Value.prio = prio
+	return q.pq.Fix(item)
+}
+
+// PeekMin returns the id with the smallest priority without removing it.
+func (q *IndexedPriorityQueue[K, P]) PeekMin() (K, P, error) {
+	top, err := q.pq.Peek()
+	return top.id, top.prio, err
+}
+
+// PopMin removes and returns the id with the smallest priority.
+func (q *IndexedPriorityQueue[K, P]) PopMin() (K, P, error) {
+	top, err := q.pq.Pop()
+	if err != nil {
+		return top.id, top.prio, err
+	}
+	delete(q.handles, top.id)
+	return top.id, top.prio, nil
+}
+
+// Remove deletes id from the queue and returns its priority.
+func (q *IndexedPriorityQueue[K, P]) Remove(id K) (P, error) {
+	item, ok := q.handles[id]
+	if !ok {
+		var zero P
+		return zero, errors.New("id is not in the priority queue")
+	}
+
+	delete(q.handles, id)
+	entry, err := q.pq.Remove(item)
+	return entry.prio, err
+}
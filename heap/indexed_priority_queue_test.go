@@ -0,0 +1,99 @@
+package heap
+
+import (
+	"testing"
+)
+
+func TestIndexedInsertAndPopMin(t *testing.T) {
+	q := NewIndexedPriorityQueue[string](intCmp)
+
+	if _, _, err := q.PopMin(); err == nil {
+		t.Error("Expected error popping an empty queue")
+	}
+
+	q.Insert("c", 30)
+	q.Insert("a", 10)
+	q.Insert("b", 20)
+
+	if err := q.Insert("a", 5); err == nil {
+		t.Error("Expected error inserting a duplicate id")
+	}
+	if q.Len() != 3 {
+		t.Errorf("Expected Len 3, got %d", q.Len())
+	}
+
+	id, prio, err := q.PeekMin()
+	if err != nil || id != "a" || prio != 10 {
+		t.Errorf("PeekMin() = %s, %d, %v; expected a, 10", id, prio, err)
+	}
+
+	for _, expected := range []string{"a", "b", "c"} {
+		id, _, err := q.PopMin()
+		if err != nil || id != expected {
+			t.Errorf("Expected %s, got %s, %v", expected, id, err)
+		}
+		if q.Contains(id) {
+			t.Errorf("Expected %s to be gone after PopMin", id)
+		}
+	}
+	if !q.IsEmpty() {
+		t.Error("Expected queue to be empty")
+	}
+}
+
+func TestIndexedDecreaseKey(t *testing.T) {
+	q := NewIndexedPriorityQueue[int](intCmp)
+	for id := range 10 {
+		q.Insert(id, 100+id)
+	}
+
+	if err := q.DecreaseKey(7, 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if prio, ok := q.Priority(7); !ok || prio != 1 {
+		t.Errorf("Expected priority 1 for id 7, got %d, %v", prio, ok)
+	}
+	if id, _, _ := q.PeekMin(); id != 7 {
+		t.Errorf("Expected id 7 on top, got %d", id)
+	}
+
+	if err := q.DecreaseKey(3, 500); err == nil {
+		t.Error("Expected error when increasing a priority with DecreaseKey")
+	}
+	if err := q.DecreaseKey(42, 0); err == nil {
+		t.Error("Expected error for a missing id")
+	}
+	if err := q.DecreaseKey(7, 1); err != nil {
+		t.Errorf("Expected DecreaseKey to the same priority to succeed, got %v", err)
+	}
+}
+
+func TestIndexedRemove(t *testing.T) {
+	q := NewIndexedPriorityQueue[string](intCmp)
+	q.Insert("x", 3)
+	q.Insert("y", 1)
+	q.Insert("z", 2)
+
+	prio, err := q.Remove("y")
+	if err != nil || prio != 1 {
+		t.Errorf("Expected to remove y with priority 1, got %d, %v", prio, err)
+	}
+	if _, err := q.Remove("y"); err == nil {
+		t.Error("Expected error removing a missing id")
+	}
+	if _, ok := q.Priority("y"); ok {
+		t.Error("Expected no priority for a removed id")
+	}
+
+	if id, _, _ := q.PopMin(); id != "z" {
+		t.Errorf("Expected z, got %s", id)
+	}
+
+	// A removed id can be inserted again.
+	if err := q.Insert("y", 0); err != nil {
+		t.Errorf("Unexpected error reinserting: %v", err)
+	}
+	if id, _, _ := q.PopMin(); id != "y" {
+		t.Errorf("Expected y, got %s", id)
+	}
+}
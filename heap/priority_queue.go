@@ -0,0 +1,162 @@
+// Package heap implements a binary min-heap priority queue ordered by a Comparator,
+// the same func(a, b T) int convention used by the bst and rbtree packages.
+package heap
+
+import "errors"
+
+// Comparator defines a function type for comparing two values of type T.
+// It returns a negative value if a < b, zero if a == b, and a positive value if a > b.
+type Comparator[T any] func(a, b T) int
+
+// Item is a handle to an element stored in a PriorityQueue.
+// After changing Value in a way that affects its priority, call Fix on the queue.
+type Item[T any] struct {
+	Value T
+	index int // position in the heap, -1 once the item has left the queue
+}
+
+// PriorityQueue is a binary min-heap: Pop and Peek return the smallest element according to the Comparator.
+type PriorityQueue[T any] struct {
+	items      []*Item[T]
+	comparator Comparator[T]
+}
+
+// NewPriorityQueue creates and returns an empty priority queue ordered by the specified comparator function.
+func NewPriorityQueue[T any](cmp Comparator[T]) *PriorityQueue[T] {
+	return &PriorityQueue[T]{comparator: cmp}
+}
+
+// Heapify builds a priority queue holding the given values in O(n).
+func Heapify[T any](values []T, cmp Comparator[T]) *PriorityQueue[T] {
+	pq := &PriorityQueue[T]{items: make([]*Item[T], len(values)), comparator: cmp}
+	for i, v := range values {
+		pq.items[i] = &Item[T]{Value: v, index: i}
+	}
+	for i := len(pq.items)/2 - 1; i >= 0; i-- {
+		pq.down(i)
+	}
+	return pq
+}
+
+// Len returns the number of elements in the queue.
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.items)
+}
+
+// IsEmpty reports whether the queue has no elements.
+func (pq *PriorityQueue[T]) IsEmpty() bool {
+	return len(pq.items) == 0
+}
+
+func (pq *PriorityQueue[T]) less(i, j int) bool {
+	return pq.comparator(pq.items[i].Value, pq.items[j].Value) < 0
+}
+
+func (pq *PriorityQueue[T]) swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.items[i].index = i
+	pq.items[j].index = j
+}
+
+// up moves the item at index i towards the root until its parent is not greater.
+func (pq *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			return
+		}
+		pq.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the item at index i towards the leaves until neither child is smaller.
+// It reports whether the item moved.
+func (pq *PriorityQueue[T]) down(i int) bool {
+	start := i
+	n := len(pq.items)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < n && pq.less(left, smallest) {
+			smallest = left
+		}
+		if right < n && pq.less(right, smallest) {
+			smallest = right
+		}
+		if smallest == i {
+			return i != start
+		}
+		pq.swap(i, smallest)
+		i = smallest
+	}
+}
+
+// Push adds elem to the queue in O(log n) and returns its handle.
+func (pq *PriorityQueue[T]) Push(elem T) *Item[T] {
+	item := &Item[T]{Value: elem, index: len(pq.items)}
+	pq.items = append(pq.items, item)
+	pq.up(item.index)
+	return item
+}
+
+// Peek returns the smallest element without removing it.
+func (pq *PriorityQueue[T]) Peek() (T, error) {
+	if pq.IsEmpty() {
+		var zero T
+		return zero, errors.New("priority queue is empty")
+	}
+	return pq.items[0].Value, nil
+}
+
+// removeAt unlinks the item at index i in O(log n) and returns it.
+func (pq *PriorityQueue[T]) removeAt(i int) *Item[T] {
+	last := len(pq.items) - 1
+	if i != last {
+		pq.swap(i, last)
+	}
+
+	item := pq.items[last]
+	pq.items[last] = nil
+	pq.items = pq.items[:last]
+	item.index = -1
+
+	if i != last && !pq.down(i) {
+		pq.up(i)
+	}
+	return item
+}
+
+// Pop removes and returns the smallest element in O(log n).
+func (pq *PriorityQueue[T]) Pop() (T, error) {
+	if pq.IsEmpty() {
+		var zero T
+		return zero, errors.New("priority queue is empty")
+	}
+	return pq.removeAt(0).Value, nil
+}
+
+// contains reports whether item is currently stored in this queue.
+func (pq *PriorityQueue[T]) contains(item *Item[T]) bool {
+	return item != nil && item.index >= 0 && item.index < len(pq.items) && pq.items[item.index] == item
+}
+
+// Fix restores the heap order after item's Value has changed, in O(log n).
+func (pq *PriorityQueue[T]) Fix(item *Item[T]) error {
+	if !pq.contains(item) {
+		return errors.New("item is not in the priority queue")
+	}
+	if !pq.down(item.index) {
+		pq.up(item.index)
+	}
+	return nil
+}
+
+// Remove deletes item from the queue in O(log n) and returns its value.
+func (pq *PriorityQueue[T]) Remove(item *Item[T]) (T, error) {
+	if !pq.contains(item) {
+		var zero T
+		return zero, errors.New("item is not in the priority queue")
+	}
+	return pq.removeAt(item.index).Value, nil
+}
@@ -0,0 +1,169 @@
+package heap
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func intCmp(a, b int) int {
+	return a - b
+}
+
+func drain[T any](t *testing.T, pq *PriorityQueue[T]) []T {
+	t.Helper()
+	var out []T
+	for !pq.IsEmpty() {
+		elem, err := pq.Pop()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		out = append(out, elem)
+	}
+	return out
+}
+
+func TestPushPop(t *testing.T) {
+	pq := NewPriorityQueue(intCmp)
+
+	if _, err := pq.Pop(); err == nil || err.Error() != "priority queue is empty" {
+		t.Errorf("Expected 'priority queue is empty', got %v", err)
+	}
+	if _, err := pq.Peek(); err == nil {
+		t.Error("Expected error peeking an empty queue")
+	}
+
+	for _, v := range []int{5, 3, 8, 1, 9, 2, 7} {
+		pq.Push(v)
+	}
+	if pq.Len() != 7 {
+		t.Errorf("Expected Len 7, got %d", pq.Len())
+	}
+	if top, _ := pq.Peek(); top != 1 {
+		t.Errorf("Expected Peek to return 1, got %d", top)
+	}
+
+	if got := drain(t, pq); !slices.Equal(got, []int{1, 2, 3, 5, 7, 8, 9}) {
+		t.Errorf("Expected ascending order, got %v", got)
+	}
+}
+
+func TestMaxHeapWithReversedComparator(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b string) int { return intCmp(len(b), len(a)) })
+	for _, s := range []string{"aa", "a", "aaaa", "aaa"} {
+		pq.Push(s)
+	}
+
+	if got := drain(t, pq); !slices.Equal(got, []string{"aaaa", "aaa", "aa", "a"}) {
+		t.Errorf("Expected longest first, got %v", got)
+	}
+}
+
+func TestHeapify(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+
+	for n := 0; n < 50; n++ {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = rng.Intn(20)
+		}
+
+		pq := Heapify(slices.Clone(values), intCmp)
+		if pq.Len() != n {
+			t.Fatalf("n=%d: expected Len %d, got %d", n, n, pq.Len())
+		}
+
+		slices.Sort(values)
+		if got := drain(t, pq); n > 0 && !slices.Equal(got, values) {
+			t.Errorf("n=%d: got %v, expected %v", n, got, values)
+		}
+	}
+}
+
+func TestFixAndRemove(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	pq := NewPriorityQueue(func(a, b *task) int { return a.priority - b.priority })
+
+	handles := make(map[string]*Item[*task])
+	for i, name := range []string{"a", "b", "c", "d", "e"} {
+		handles[name] = pq.Push(&task{name: name, priority: (i + 1) * 10})
+	}
+
+	handles["e"].Value.priority = 1
+	if err := pq.Fix(handles["e"]); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if top, _ := pq.Peek(); top.name != "e" {
+		t.Errorf("Expected e on top after lowering its priority, got %s", top.name)
+	}
+
+	handles["e"].Value.priority = 100
+	pq.Fix(handles["e"])
+	if top, _ := pq.Peek(); top.name != "a" {
+		t.Errorf("Expected a on top after raising e's priority, got %s", top.name)
+	}
+
+	removed, err := pq.Remove(handles["c"])
+	if err != nil || removed.name != "c" {
+		t.Errorf("Expected to remove c, got %v, %v", removed, err)
+	}
+	if _, err := pq.Remove(handles["c"]); err == nil {
+		t.Error("Expected error removing an item twice")
+	}
+	if err := pq.Fix(handles["c"]); err == nil {
+		t.Error("Expected error fixing a removed item")
+	}
+
+	var names []string
+	for _, tk := range drain(t, pq) {
+		names = append(names, tk.name)
+	}
+	if !slices.Equal(names, []string{"a", "b", "d", "e"}) {
+		t.Errorf("Expected [a b d e], got %v", names)
+	}
+
+	if _, err := pq.Remove(handles["a"]); err == nil {
+		t.Error("Expected error removing a popped item")
+	}
+}
+
+func TestRandomOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(9))
+	pq := NewPriorityQueue(intCmp)
+	var handles []*Item[int]
+	var expected []int
+
+	for i := 0; i < 2000; i++ {
+		switch rng.Intn(4) {
+		case 0, 1:
+			v := rng.Intn(1000)
+			handles = append(handles, pq.Push(v))
+			expected = append(expected, v)
+		case 2:
+			if pq.IsEmpty() {
+				continue
+			}
+			got, _ := pq.Pop()
+			if minimum := slices.Min(expected); got != minimum {
+				t.Fatalf("Step %d: expected %d, got %d", i, minimum, got)
+			}
+			expected = slices.Delete(expected, slices.Index(expected, got), slices.Index(expected, got)+1)
+		case 3:
+			if len(handles) == 0 {
+				continue
+			}
+			h := handles[rng.Intn(len(handles))]
+			if v, err := pq.Remove(h); err == nil {
+				expected = slices.Delete(expected, slices.Index(expected, v), slices.Index(expected, v)+1)
+			}
+		}
+	}
+
+	slices.Sort(expected)
+	if got := drain(t, pq); len(expected) > 0 && !slices.Equal(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
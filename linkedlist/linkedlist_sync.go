@@ -0,0 +1,78 @@
+package linkedlist
+
+import "sync"
+
+// SyncLinkedList is a LinkedList that is safe for concurrent use by multiple goroutines.
+// It never hands out *Node values, since following their links would bypass the lock;
+// membership is checked with Contains instead of Search.
+type SyncLinkedList[T comparable] struct {
+	mu   sync.Mutex
+	list *LinkedList[T]
+}
+
+func CreateSyncLinkedList[T comparable]() *SyncLinkedList[T] {
+	return &SyncLinkedList[T]{list: CreateLinkedList[T]()}
+}
+
+func (s *SyncLinkedList[T]) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Size()
+}
+
+func (s *SyncLinkedList[T]) Contains(target T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Search(target) != nil
+}
+
+func (s *SyncLinkedList[T]) Insert(key T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Insert(key)
+}
+
+func (s *SyncLinkedList[T]) Append(key T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Append(key)
+}
+
+func (s *SyncLinkedList[T]) Remove(target T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Remove(target)
+}
+
+// InsertIfAbsent inserts key at the head and reports true, or reports false if key is already in the list.
+func (s *SyncLinkedList[T]) InsertIfAbsent(key T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.list.Search(key) != nil {
+		return false
+	}
+	s.list.Insert(key)
+	return true
+}
+
+// AppendIfAbsent appends key at the tail and reports true, or reports false if key is already in the list.
+func (s *SyncLinkedList[T]) AppendIfAbsent(key T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.list.Search(key) != nil {
+		return false
+	}
+	s.list.Append(key)
+	return true
+}
+
+// DrainTo removes every element from head to tail, appends them to dst and returns the extended slice.
+func (s *SyncLinkedList[T]) DrainTo(dst []T) []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for pt := s.list.head; pt != nil; pt = pt.next {
+		dst = append(dst, pt.Key)
+	}
+	*s.list = *CreateLinkedList[T]()
+	return dst
+}
@@ -0,0 +1,82 @@
+package linkedlist
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSyncLinkedListOperations(t *testing.T) {
+	list := CreateSyncLinkedList[int]()
+
+	list.Append(2)
+	list.Insert(1)
+	list.Append(3)
+	if list.Size() != 3 {
+		t.Errorf("Expected size 3, got %d", list.Size())
+	}
+	if !list.Contains(2) || list.Contains(4) {
+		t.Error("Contains reported wrong membership")
+	}
+
+	if list.AppendIfAbsent(2) || list.InsertIfAbsent(3) {
+		t.Error("Expected IfAbsent operations to reject existing keys")
+	}
+	if !list.AppendIfAbsent(4) || !list.InsertIfAbsent(0) {
+		t.Error("Expected IfAbsent operations to add new keys")
+	}
+
+	if err := list.Remove(42); err == nil {
+		t.Error("Expected error when removing a missing key")
+	}
+
+	drained := list.DrainTo(nil)
+	if !slices.Equal(drained, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("Expected DrainTo to return [0 1 2 3 4], got %v", drained)
+	}
+	if list.Size() != 0 || list.Contains(1) {
+		t.Error("Expected list to be empty after DrainTo")
+	}
+}
+
+func TestSyncLinkedListConcurrentAppendIfAbsent(t *testing.T) {
+	const workers, keys = 8, 100
+	list := CreateSyncLinkedList[int]()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	added := 0
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := range keys {
+				if list.AppendIfAbsent(k) {
+					mu.Lock()
+					added++
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if added != keys || list.Size() != keys {
+		t.Errorf("Expected each key to be added exactly once, added %d with size %d", added, list.Size())
+	}
+
+	for k := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := list.Remove(k); err != nil {
+				t.Errorf("Unexpected error removing %d: %v", k, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if list.Size() != 0 {
+		t.Errorf("Expected empty list, got size %d", list.Size())
+	}
+}
@@ -0,0 +1,123 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrClosed is returned by BlockingQueue operations once the queue has been closed
+// and, for Take, drained.
+var ErrClosed = errors.New("queue is closed")
+
+// BlockingQueue is a bounded FIFO queue for producer/consumer pipelines. Put waits
+// for free space and Take waits for an element instead of failing immediately, and
+// both give up when their context is cancelled. It is safe for concurrent use.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	q        *Queue[T]
+	closed   bool
+}
+
+// NewBlockingQueue creates a queue that holds up to size elements.
+func NewBlockingQueue[T any](size uint) (*BlockingQueue[T], error) {
+	if size == 0 {
+		return nil, errors.New("size must be greater than 0")
+	}
+
+	// Queue keeps one slot free to tell a full queue from an empty one.
+	q, err := NewQueue[T](size + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &BlockingQueue[T]{q: q}
+	b.notEmpty = sync.NewCond(&b.mu)
+	b.notFull = sync.NewCond(&b.mu)
+	return b, nil
+}
+
+// wait blocks on cond until it is signalled or ctx is done. It must be called with b.mu held.
+func (b *BlockingQueue[T]) wait(ctx context.Context, cond *sync.Cond) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		cond.Broadcast()
+	})
+	defer stop()
+
+	cond.Wait()
+	return ctx.Err()
+}
+
+// Put adds elem to the back of the queue, waiting while the queue is full.
+// It returns ErrClosed if the queue is closed, or the context's error if ctx is done first.
+func (b *BlockingQueue[T]) Put(ctx context.Context, elem T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for !b.closed && b.q.IsFull() {
+		if err := b.wait(ctx, b.notFull); err != nil {
+			return err
+		}
+	}
+	if b.closed {
+		return ErrClosed
+	}
+
+	b.q.Enqueue(elem)
+	b.notEmpty.Broadcast()
+	return nil
+}
+
+// Take removes and returns the front element, waiting while the queue is empty.
+// After Close, Take keeps returning the remaining elements and then returns ErrClosed.
+// It returns the context's error if ctx is done before an element is available.
+func (b *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for !b.closed && b.q.IsEmpty() {
+		if err := b.wait(ctx, b.notEmpty); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+	if b.q.IsEmpty() {
+		var zero T
+		return zero, ErrClosed
+	}
+
+	elem, _ := b.q.Dequeue()
+	b.notFull.Broadcast()
+	return elem, nil
+}
+
+// Close stops the queue from accepting new elements and wakes every waiting goroutine.
+// Elements already in the queue can still be taken. Closing an already closed queue is a no-op.
+func (b *BlockingQueue[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	b.notEmpty.Broadcast()
+	b.notFull.Broadcast()
+}
+
+func (b *BlockingQueue[T]) IsEmpty() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.q.IsEmpty()
+}
+
+func (b *BlockingQueue[T]) IsFull() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.q.IsFull()
+}
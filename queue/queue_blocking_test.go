@@ -0,0 +1,216 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewBlockingQueue(t *testing.T) {
+	queue, err := NewBlockingQueue[int](0)
+	if err == nil || queue != nil {
+		t.Error("Expected error and nil queue when size is 0")
+	}
+
+	queue, err = NewBlockingQueue[int](2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ctx := context.Background()
+	queue.Put(ctx, 1)
+	queue.Put(ctx, 2)
+	if !queue.IsFull() {
+		t.Error("Expected queue of size 2 to be full after 2 elements")
+	}
+}
+
+func TestBlockingQueueFIFO(t *testing.T) {
+	queue, _ := NewBlockingQueue[int](3)
+	ctx := context.Background()
+
+	for _, v := range []int{1, 2, 3} {
+		if err := queue.Put(ctx, v); err != nil {
+			t.Fatalf("Unexpected error putting %d: %v", v, err)
+		}
+	}
+	for _, expected := range []int{1, 2, 3} {
+		elem, err := queue.Take(ctx)
+		if err != nil || elem != expected {
+			t.Errorf("Expected %d, got %d, %v", expected, elem, err)
+		}
+	}
+	if !queue.IsEmpty() {
+		t.Error("Expected queue to be empty")
+	}
+}
+
+func TestBlockingQueueTakeWaitsForPut(t *testing.T) {
+	queue, _ := NewBlockingQueue[string](1)
+
+	result := make(chan string)
+	go func() {
+		elem, err := queue.Take(context.Background())
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		result <- elem
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	queue.Put(context.Background(), "hello")
+
+	if elem := <-result; elem != "hello" {
+		t.Errorf("Expected 'hello', got %s", elem)
+	}
+}
+
+func TestBlockingQueuePutWaitsForTake(t *testing.T) {
+	queue, _ := NewBlockingQueue[int](1)
+	ctx := context.Background()
+	queue.Put(ctx, 1)
+
+	done := make(chan error)
+	go func() {
+		done <- queue.Put(ctx, 2)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Expected Put to block on a full queue")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	if elem, _ := queue.Take(ctx); elem != 1 {
+		t.Errorf("Expected 1, got %d", elem)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if elem, _ := queue.Take(ctx); elem != 2 {
+		t.Errorf("Expected 2, got %d", elem)
+	}
+}
+
+func TestBlockingQueueContextCancellation(t *testing.T) {
+	queue, _ := NewBlockingQueue[int](1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := queue.Take(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded from Take, got %v", err)
+	}
+
+	queue.Put(context.Background(), 1)
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := queue.Put(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded from Put, got %v", err)
+	}
+
+	cancelled, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+	if err := queue.Put(cancelled, 3); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected Canceled from Put, got %v", err)
+	}
+	if elem, err := queue.Take(context.Background()); err != nil || elem != 1 {
+		t.Errorf("Expected the queue to keep its element after cancellations, got %d, %v", elem, err)
+	}
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	queue, _ := NewBlockingQueue[int](3)
+	ctx := context.Background()
+	queue.Put(ctx, 1)
+	queue.Put(ctx, 2)
+
+	queue.Close()
+	queue.Close()
+
+	if err := queue.Put(ctx, 3); !errors.Is(err, ErrClosed) {
+		t.Errorf("Expected ErrClosed from Put after Close, got %v", err)
+	}
+	for _, expected := range []int{1, 2} {
+		elem, err := queue.Take(ctx)
+		if err != nil || elem != expected {
+			t.Errorf("Expected to drain %d after Close, got %d, %v", expected, elem, err)
+		}
+	}
+	if _, err := queue.Take(ctx); !errors.Is(err, ErrClosed) {
+		t.Errorf("Expected ErrClosed once drained, got %v", err)
+	}
+}
+
+func TestBlockingQueueCloseWakesWaiters(t *testing.T) {
+	queue, _ := NewBlockingQueue[int](1)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 3)
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := queue.Take(context.Background())
+			errs <- err
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	queue.Close()
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if !errors.Is(err, ErrClosed) {
+			t.Errorf("Expected ErrClosed, got %v", err)
+		}
+	}
+}
+
+func TestBlockingQueueProducerConsumer(t *testing.T) {
+	const producers, perProducer = 4, 250
+	queue, _ := NewBlockingQueue[int](8)
+	ctx := context.Background()
+
+	var producerWg sync.WaitGroup
+	for p := range producers {
+		producerWg.Add(1)
+		go func() {
+			defer producerWg.Done()
+			for i := range perProducer {
+				if err := queue.Put(ctx, p*perProducer+i); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}
+		}()
+	}
+
+	var consumerWg sync.WaitGroup
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	for range 3 {
+		consumerWg.Add(1)
+		go func() {
+			defer consumerWg.Done()
+			for {
+				elem, err := queue.Take(ctx)
+				if errors.Is(err, ErrClosed) {
+					return
+				}
+				mu.Lock()
+				seen[elem] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	producerWg.Wait()
+	queue.Close()
+	consumerWg.Wait()
+
+	if len(seen) != producers*perProducer {
+		t.Errorf("Expected %d distinct elements, got %d", producers*perProducer, len(seen))
+	}
+}
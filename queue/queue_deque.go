@@ -0,0 +1,126 @@
+package queue
+
+import "errors"
+
+const minDequeCapacity = 8
+
+// Deque is a double-ended queue backed by a ring buffer that grows and shrinks as needed.
+// Like Queue it addresses the buffer with modular indices, but it tracks the element
+// count explicitly, so no slot is wasted. Pushes, pops and At run in amortised O(1).
+// The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	front int
+	size  int
+	arr   []T
+}
+
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{arr: make([]T, minDequeCapacity)}
+}
+
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// index maps a logical position, counted from the front, to a slot of the ring buffer.
+func (d *Deque[T]) index(i int) int {
+	return (d.front + i) % len(d.arr)
+}
+
+// resize moves the elements, in order, into a new buffer of the given capacity.
+func (d *Deque[T]) resize(capacity int) {
+	arr := make([]T, capacity)
+	for i := 0; i < d.size; i++ {
+		arr[i] = d.arr[d.index(i)]
+	}
+	d.arr = arr
+	d.front = 0
+}
+
+// grow doubles the buffer when it is full.
+func (d *Deque[T]) grow() {
+	if len(d.arr) == 0 {
+		d.arr = make([]T, minDequeCapacity)
+		return
+	}
+	if d.size == len(d.arr) {
+		d.resize(2 * len(d.arr))
+	}
+}
+
+// shrink halves the buffer when it is at most a quarter full.
+func (d *Deque[T]) shrink() {
+	if len(d.arr) > minDequeCapacity && d.size <= len(d.arr)/4 {
+		d.resize(len(d.arr) / 2)
+	}
+}
+
+func (d *Deque[T]) PushBack(elem T) {
+	d.grow()
+	d.arr[d.index(d.size)] = elem
+	d.size++
+}
+
+func (d *Deque[T]) PushFront(elem T) {
+	d.grow()
+	d.front = (d.front - 1 + len(d.arr)) % len(d.arr)
+	d.arr[d.front] = elem
+	d.size++
+}
+
+func (d *Deque[T]) PopFront() (T, error) {
+	var zero T
+	if d.IsEmpty() {
+		return zero, errors.New("deque is empty")
+	}
+
+	elem := d.arr[d.front]
+	d.arr[d.front] = zero
+	d.front = (d.front + 1) % len(d.arr)
+	d.size--
+	d.shrink()
+	return elem, nil
+}
+
+func (d *Deque[T]) PopBack() (T, error) {
+	var zero T
+	if d.IsEmpty() {
+		return zero, errors.New("deque is empty")
+	}
+
+	back := d.index(d.size - 1)
+	elem := d.arr[back]
+	d.arr[back] = zero
+	d.size--
+	d.shrink()
+	return elem, nil
+}
+
+func (d *Deque[T]) PeekFront() (T, error) {
+	if d.IsEmpty() {
+		var zero T
+		return zero, errors.New("deque is empty")
+	}
+	return d.arr[d.front], nil
+}
+
+func (d *Deque[T]) PeekBack() (T, error) {
+	if d.IsEmpty() {
+		var zero T
+		return zero, errors.New("deque is empty")
+	}
+	return d.arr[d.index(d.size-1)], nil
+}
+
+// At returns the element at position i, counting from the front.
+func (d *Deque[T]) At(i int) (T, error) {
+	if i < 0 || i >= d.size {
+		var zero T
+		return zero, errors.New("index out of range")
+	}
+	return d.arr[d.index(i)], nil
+}
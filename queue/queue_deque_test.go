@@ -0,0 +1,126 @@
+package queue
+
+import (
+	"testing"
+)
+
+func TestNewDeque(t *testing.T) {
+	deque := NewDeque[int]()
+	if !deque.IsEmpty() || deque.Len() != 0 {
+		t.Error("Expected new deque to be empty")
+	}
+	if len(deque.arr) != minDequeCapacity {
+		t.Errorf("Expected initial capacity %d, got %d", minDequeCapacity, len(deque.arr))
+	}
+}
+
+func TestDequeEmptyErrors(t *testing.T) {
+	var deque Deque[int]
+
+	if _, err := deque.PopFront(); err == nil || err.Error() != "deque is empty" {
+		t.Errorf("Expected 'deque is empty' from PopFront, got %v", err)
+	}
+	if _, err := deque.PopBack(); err == nil || err.Error() != "deque is empty" {
+		t.Errorf("Expected 'deque is empty' from PopBack, got %v", err)
+	}
+	if _, err := deque.PeekFront(); err == nil {
+		t.Error("Expected error from PeekFront on empty deque")
+	}
+	if _, err := deque.PeekBack(); err == nil {
+		t.Error("Expected error from PeekBack on empty deque")
+	}
+	if _, err := deque.At(0); err == nil || err.Error() != "index out of range" {
+		t.Errorf("Expected 'index out of range' from At, got %v", err)
+	}
+}
+
+func TestDequeBothEnds(t *testing.T) {
+	var deque Deque[int]
+
+	deque.PushBack(2)
+	deque.PushFront(1)
+	deque.PushBack(3)
+	deque.PushFront(0)
+
+	if deque.Len() != 4 {
+		t.Errorf("Expected Len 4, got %d", deque.Len())
+	}
+	for i := range 4 {
+		elem, err := deque.At(i)
+		if err != nil || elem != i {
+			t.Errorf("At(%d) = %d, %v; expected %d", i, elem, err, i)
+		}
+	}
+
+	front, _ := deque.PeekFront()
+	back, _ := deque.PeekBack()
+	if front != 0 || back != 3 {
+		t.Errorf("Expected front 0 and back 3, got %d and %d", front, back)
+	}
+
+	if elem, _ := deque.PopFront(); elem != 0 {
+		t.Errorf("Expected PopFront to return 0, got %d", elem)
+	}
+	if elem, _ := deque.PopBack(); elem != 3 {
+		t.Errorf("Expected PopBack to return 3, got %d", elem)
+	}
+	if elem, _ := deque.PopBack(); elem != 2 {
+		t.Errorf("Expected PopBack to return 2, got %d", elem)
+	}
+	if elem, _ := deque.PopFront(); elem != 1 {
+		t.Errorf("Expected PopFront to return 1, got %d", elem)
+	}
+	if !deque.IsEmpty() {
+		t.Error("Expected deque to be empty")
+	}
+}
+
+func TestDequeGrowAndShrink(t *testing.T) {
+	deque := NewDeque[int]()
+	const n = 1000
+
+	// Alternate ends so the elements wrap around the buffer while it grows.
+	for i := range n {
+		if i%2 == 0 {
+			deque.PushBack(i)
+		} else {
+			deque.PushFront(i)
+		}
+	}
+	if deque.Len() != n {
+		t.Fatalf("Expected Len %d, got %d", n, deque.Len())
+	}
+	if len(deque.arr) < n {
+		t.Errorf("Expected capacity of at least %d, got %d", n, len(deque.arr))
+	}
+
+	first, _ := deque.At(0)
+	last, _ := deque.At(n - 1)
+	if first != n-1 || last != n-2 {
+		t.Errorf("Expected ends %d and %d, got %d and %d", n-1, n-2, first, last)
+	}
+
+	for deque.Len() > 0 {
+		deque.PopFront()
+	}
+	if len(deque.arr) != minDequeCapacity {
+		t.Errorf("Expected capacity to shrink back to %d, got %d", minDequeCapacity, len(deque.arr))
+	}
+}
+
+func TestDequeAsQueue(t *testing.T) {
+	deque := NewDeque[string]()
+
+	for round := range 5 {
+		for i := range 20 {
+			deque.PushBack(string(rune('a' + (round+i)%26)))
+		}
+		for i := range 20 {
+			elem, err := deque.PopFront()
+			expected := string(rune('a' + (round+i)%26))
+			if err != nil || elem != expected {
+				t.Fatalf("Round %d: expected %s, got %s, %v", round, expected, elem, err)
+			}
+		}
+	}
+}
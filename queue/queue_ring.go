@@ -0,0 +1,88 @@
+package queue
+
+import "errors"
+
+// RingBuffer is a fixed-size circular queue that never rejects an Enqueue: when it is
+// full, the oldest element is overwritten. It is meant for keeping the latest N samples.
+type RingBuffer[T any] struct {
+	capacity uint
+	front    int
+	size     int
+	dropped  int
+	arr      []T
+}
+
+// NewRingBuffer creates a ring buffer that holds the latest size elements.
+func NewRingBuffer[T any](size uint) (*RingBuffer[T], error) {
+	if size == 0 {
+		return nil, errors.New("size must be greater than 0")
+	}
+
+	return &RingBuffer[T]{
+		capacity: size,
+		arr:      make([]T, size),
+	}, nil
+}
+
+func (r RingBuffer[T]) Len() int {
+	return r.size
+}
+
+func (r RingBuffer[T]) IsEmpty() bool {
+	return r.size == 0
+}
+
+func (r RingBuffer[T]) IsFull() bool {
+	return r.size == int(r.capacity)
+}
+
+// Dropped returns the total number of elements overwritten since the buffer was created.
+func (r RingBuffer[T]) Dropped() int {
+	return r.dropped
+}
+
+// Enqueue appends elem, overwriting the oldest element if the buffer is full.
+// It reports whether an element was dropped to make room.
+func (r *RingBuffer[T]) Enqueue(elem T) bool {
+	rear := (r.front + r.size) % int(r.capacity)
+	r.arr[rear] = elem
+
+	if r.IsFull() {
+		r.front = (r.front + 1) % int(r.capacity)
+		r.dropped++
+		return true
+	}
+
+	r.size++
+	return false
+}
+
+func (r *RingBuffer[T]) Dequeue() (T, error) {
+	var zero T
+	if r.IsEmpty() {
+		return zero, errors.New("queue is empty")
+	}
+
+	elem := r.arr[r.front]
+	r.arr[r.front] = zero
+	r.front = (r.front + 1) % int(r.capacity)
+	r.size--
+	return elem, nil
+}
+
+func (r *RingBuffer[T]) Peek() (T, error) {
+	if r.IsEmpty() {
+		var zero T
+		return zero, errors.New("queue is empty")
+	}
+	return r.arr[r.front], nil
+}
+
+// Snapshot returns a copy of the buffered elements from oldest to newest without removing them.
+func (r *RingBuffer[T]) Snapshot() []T {
+	out := make([]T, r.size)
+	for i := range out {
+		out[i] = r.arr[(r.front+i)%int(r.capacity)]
+	}
+	return out
+}
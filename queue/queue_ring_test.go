@@ -0,0 +1,100 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewRingBuffer(t *testing.T) {
+	ring, err := NewRingBuffer[int](0)
+	if err == nil || ring != nil {
+		t.Error("Expected error and nil ring buffer when size is 0")
+	}
+
+	ring, err = NewRingBuffer[int](3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !ring.IsEmpty() || ring.IsFull() || ring.Len() != 0 {
+		t.Error("Expected new ring buffer to be empty")
+	}
+}
+
+func TestRingBufferOverwritesOldest(t *testing.T) {
+	ring, _ := NewRingBuffer[int](3)
+
+	for i := 1; i <= 3; i++ {
+		if ring.Enqueue(i) {
+			t.Errorf("Did not expect a drop while enqueueing %d", i)
+		}
+	}
+	if !ring.IsFull() {
+		t.Error("Expected ring buffer to hold all 3 elements")
+	}
+
+	if !ring.Enqueue(4) || !ring.Enqueue(5) {
+		t.Error("Expected Enqueue on full buffer to report a drop")
+	}
+	if ring.Dropped() != 2 {
+		t.Errorf("Expected 2 dropped elements, got %d", ring.Dropped())
+	}
+	if ring.Len() != 3 {
+		t.Errorf("Expected Len 3, got %d", ring.Len())
+	}
+
+	if snapshot := ring.Snapshot(); !slices.Equal(snapshot, []int{3, 4, 5}) {
+		t.Errorf("Expected snapshot [3 4 5], got %v", snapshot)
+	}
+	if ring.Len() != 3 {
+		t.Error("Snapshot must not remove elements")
+	}
+
+	front, err := ring.Peek()
+	if err != nil || front != 3 {
+		t.Errorf("Expected Peek to return 3, got %d, %v", front, err)
+	}
+}
+
+func TestRingBufferDequeue(t *testing.T) {
+	ring, _ := NewRingBuffer[string](2)
+
+	if _, err := ring.Dequeue(); err == nil || err.Error() != "queue is empty" {
+		t.Errorf("Expected 'queue is empty', got %v", err)
+	}
+	if _, err := ring.Peek(); err == nil {
+		t.Error("Expected error peeking an empty ring buffer")
+	}
+
+	ring.Enqueue("a")
+	ring.Enqueue("b")
+	ring.Enqueue("c")
+
+	for _, expected := range []string{"b", "c"} {
+		elem, err := ring.Dequeue()
+		if err != nil || elem != expected {
+			t.Errorf("Expected %s, got %s, %v", expected, elem, err)
+		}
+	}
+	if !ring.IsEmpty() {
+		t.Error("Expected ring buffer to be empty")
+	}
+
+	ring.Enqueue("d")
+	if snapshot := ring.Snapshot(); !slices.Equal(snapshot, []string{"d"}) {
+		t.Errorf("Expected snapshot [d], got %v", snapshot)
+	}
+}
+
+func TestRingBufferSizeOne(t *testing.T) {
+	ring, _ := NewRingBuffer[int](1)
+
+	for i := range 5 {
+		ring.Enqueue(i)
+	}
+	if ring.Dropped() != 4 {
+		t.Errorf("Expected 4 dropped elements, got %d", ring.Dropped())
+	}
+	if snapshot := ring.Snapshot(); !slices.Equal(snapshot, []int{4}) {
+		t.Errorf("Expected snapshot [4], got %v", snapshot)
+	}
+}
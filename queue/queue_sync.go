@@ -0,0 +1,75 @@
+package queue
+
+import "sync"
+
+// SyncQueue is a Queue that is safe for concurrent use by multiple goroutines.
+// Besides the Queue operations it offers compound operations that check and act
+// under a single lock, avoiding IsEmpty/Dequeue style check-then-act races.
+type SyncQueue[T any] struct {
+	mu sync.Mutex
+	q  *Queue[T]
+}
+
+func NewSyncQueue[T any](size uint) (*SyncQueue[T], error) {
+	q, err := NewQueue[T](size)
+	if err != nil {
+		return nil, err
+	}
+	return &SyncQueue[T]{q: q}, nil
+}
+
+func (s *SyncQueue[T]) IsEmpty() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.q.IsEmpty()
+}
+
+func (s *SyncQueue[T]) IsFull() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.q.IsFull()
+}
+
+func (s *SyncQueue[T]) Enqueue(elem T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.q.Enqueue(elem)
+}
+
+func (s *SyncQueue[T]) Dequeue() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.q.Dequeue()
+}
+
+func (s *SyncQueue[T]) Peek() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.q.Peek()
+}
+
+// EnqueueIfNotFull adds elem and reports true, or reports false if the queue is full.
+func (s *SyncQueue[T]) EnqueueIfNotFull(elem T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.q.Enqueue(elem) == nil
+}
+
+// TryDequeue removes and returns the front element, or reports false if the queue is empty.
+func (s *SyncQueue[T]) TryDequeue() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	elem, err := s.q.Dequeue()
+	return elem, err == nil
+}
+
+// DrainTo removes every element in FIFO order, appends them to dst and returns the extended slice.
+func (s *SyncQueue[T]) DrainTo(dst []T) []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for !s.q.IsEmpty() {
+		elem, _ := s.q.Dequeue()
+		dst = append(dst, elem)
+	}
+	return dst
+}
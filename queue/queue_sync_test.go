@@ -0,0 +1,113 @@
+package queue
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestNewSyncQueue(t *testing.T) {
+	queue, err := NewSyncQueue[int](0)
+	if err == nil {
+		t.Error("Expected error when creating queue with size 0")
+	}
+	if queue != nil {
+		t.Error("Expected nil queue when size is 0")
+	}
+
+	queue, err = NewSyncQueue[int](3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !queue.IsEmpty() || queue.IsFull() {
+		t.Error("Expected new queue to be empty and not full")
+	}
+}
+
+func TestSyncQueueCompoundOperations(t *testing.T) {
+	queue, _ := NewSyncQueue[int](3)
+
+	if _, ok := queue.TryDequeue(); ok {
+		t.Error("Expected TryDequeue on empty queue to report false")
+	}
+
+	if !queue.EnqueueIfNotFull(1) || !queue.EnqueueIfNotFull(2) {
+		t.Error("Expected EnqueueIfNotFull to succeed while there is room")
+	}
+	if queue.EnqueueIfNotFull(3) {
+		t.Error("Expected EnqueueIfNotFull on full queue to report false")
+	}
+
+	elem, ok := queue.TryDequeue()
+	if !ok || elem != 1 {
+		t.Errorf("Expected TryDequeue to return 1, got %d, %v", elem, ok)
+	}
+
+	queue.Enqueue(3)
+	drained := queue.DrainTo([]int{0})
+	if !slices.Equal(drained, []int{0, 2, 3}) {
+		t.Errorf("Expected DrainTo to append [2 3], got %v", drained)
+	}
+	if !queue.IsEmpty() {
+		t.Error("Expected queue to be empty after DrainTo")
+	}
+}
+
+func TestSyncQueueConcurrentProducersAndConsumers(t *testing.T) {
+	const producers, perProducer = 4, 500
+	queue, _ := NewSyncQueue[int](16)
+
+	var wg sync.WaitGroup
+	for p := range producers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perProducer {
+				for !queue.EnqueueIfNotFull(p*perProducer + i) {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	done := make(chan struct{})
+	var consumers sync.WaitGroup
+	for range producers {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for {
+				elem, ok := queue.TryDequeue()
+				if !ok {
+					select {
+					case <-done:
+						return
+					default:
+						runtime.Gosched()
+						continue
+					}
+				}
+				mu.Lock()
+				if seen[elem] {
+					t.Errorf("Element %d dequeued twice", elem)
+				}
+				seen[elem] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	for !queue.IsEmpty() {
+		runtime.Gosched()
+	}
+	close(done)
+	consumers.Wait()
+
+	if len(seen) != producers*perProducer {
+		t.Errorf("Expected %d distinct elements, got %d", producers*perProducer, len(seen))
+	}
+}
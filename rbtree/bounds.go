@@ -0,0 +1,101 @@
+package rbtree
+
+// ceiling returns the node with the smallest value greater than or equal to elem,
+// or the sentinel Nil node if no such node exists.
+func (t *Tree[T]) ceiling(elem T) *Node[T] {
+	result := t.Nil
+	current := t.Root
+
+	for current != t.Nil {
+		if t.Comparator(elem, current.Val) <= 0 {
+			result = current
+			current = current.Left
+		} else {
+			current = current.Right
+		}
+	}
+
+	return result
+}
+
+// lower returns the node with the largest value strictly less than elem,
+// or the sentinel Nil node if no such node exists.
+func (t *Tree[T]) lower(elem T) *Node[T] {
+	result := t.Nil
+	current := t.Root
+
+	for current != t.Nil {
+		if t.Comparator(elem, current.Val) > 0 {
+			result = current
+			current = current.Right
+		} else {
+			current = current.Left
+		}
+	}
+
+	return result
+}
+
+// floor returns the node with the largest value less than or equal to elem,
+// or the sentinel Nil node if no such node exists.
+func (t *Tree[T]) floor(elem T) *Node[T] {
+	result := t.Nil
+	current := t.Root
+
+	for current != t.Nil {
+		if t.Comparator(elem, current.Val) >= 0 {
+			result = current
+			current = current.Right
+		} else {
+			current = current.Left
+		}
+	}
+
+	return result
+}
+
+// higher returns the node with the smallest value strictly greater than elem,
+// or the sentinel Nil node if no such node exists.
+func (t *Tree[T]) higher(elem T) *Node[T] {
+	result := t.Nil
+	current := t.Root
+
+	for current != t.Nil {
+		if t.Comparator(elem, current.Val) < 0 {
+			result = current
+			current = current.Left
+		} else {
+			current = current.Right
+		}
+	}
+
+	return result
+}
+
+// Floor returns the node with the largest value less than or equal to elem.
+// The boolean is false, and the node is the sentinel Nil node, if no such value exists.
+func (t *Tree[T]) Floor(elem T) (*Node[T], bool) {
+	node := t.floor(elem)
+	return node, node != t.Nil
+}
+
+// Ceiling returns the node with the smallest value greater than or equal to elem.
+// The boolean is false, and the node is the sentinel Nil node, if no such value exists.
+func (t *Tree[T]) Ceiling(elem T) (*Node[T], bool) {
+	node := t.ceiling(elem)
+	return node, node != t.Nil
+}
+
+// Lower returns the node with the largest value strictly less than elem.
+// The boolean is false, and the node is the sentinel Nil node, if no such value exists.
+func (t *Tree[T]) Lower(elem T) (*Node[T], bool) {
+	node := t.lower(elem)
+	return node, node != t.Nil
+}
+
+// Higher returns the node with the smallest value strictly greater than elem.
+// The boolean is false, and the node is the sentinel Nil node, if no such value exists.
+func (t *Tree[T]) Higher(elem T) (*Node[T], bool) {
+	node := t.higher(elem)
+	return node, node != t.Nil
+}
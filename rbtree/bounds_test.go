@@ -0,0 +1,64 @@
+package rbtree
+
+import (
+	"testing"
+)
+
+func TestFloorCeilingLowerHigher(t *testing.T) {
+	tree := newIntTree(20, 10, 30, 5, 15, 25, 35)
+
+	tests := []struct {
+		name   string
+		query  func(int) (*Node[int], bool)
+		elem   int
+		want   int
+		wantOk bool
+	}{
+		{name: "floor exact", query: tree.Floor, elem: 15, want: 15, wantOk: true},
+		{name: "floor between", query: tree.Floor, elem: 24, want: 20, wantOk: true},
+		{name: "floor above maximum", query: tree.Floor, elem: 100, want: 35, wantOk: true},
+		{name: "floor below minimum", query: tree.Floor, elem: 1, wantOk: false},
+		{name: "ceiling exact", query: tree.Ceiling, elem: 15, want: 15, wantOk: true},
+		{name: "ceiling between", query: tree.Ceiling, elem: 16, want: 20, wantOk: true},
+		{name: "ceiling below minimum", query: tree.Ceiling, elem: 1, want: 5, wantOk: true},
+		{name: "ceiling above maximum", query: tree.Ceiling, elem: 36, wantOk: false},
+		{name: "lower exact", query: tree.Lower, elem: 15, want: 10, wantOk: true},
+		{name: "lower between", query: tree.Lower, elem: 26, want: 25, wantOk: true},
+		{name: "lower minimum", query: tree.Lower, elem: 5, wantOk: false},
+		{name: "higher exact", query: tree.Higher, elem: 15, want: 20, wantOk: true},
+		{name: "higher between", query: tree.Higher, elem: 26, want: 30, wantOk: true},
+		{name: "higher maximum", query: tree.Higher, elem: 35, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, ok := tt.query(tt.elem)
+			if ok != tt.wantOk {
+				t.Fatalf("Expected ok=%v, got %v", tt.wantOk, ok)
+			}
+			if !ok && node != tree.Nil {
+				t.Errorf("Expected sentinel Nil node when not found, got %v", node)
+			}
+			if ok && node.Val != tt.want {
+				t.Errorf("Expected %d, got %d", tt.want, node.Val)
+			}
+		})
+	}
+}
+
+func TestFloorCeilingEmptyTree(t *testing.T) {
+	tree := newIntTree()
+
+	if _, ok := tree.Floor(10); ok {
+		t.Error("Expected Floor on empty tree to report false")
+	}
+	if _, ok := tree.Ceiling(10); ok {
+		t.Error("Expected Ceiling on empty tree to report false")
+	}
+	if _, ok := tree.Lower(10); ok {
+		t.Error("Expected Lower on empty tree to report false")
+	}
+	if _, ok := tree.Higher(10); ok {
+		t.Error("Expected Higher on empty tree to report false")
+	}
+}
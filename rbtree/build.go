@@ -0,0 +1,62 @@
+package rbtree
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// ErrNotSorted is returned by FromSorted when the input is not in ascending order.
+var ErrNotSorted = errors.New("input is not sorted")
+
+// FromSorted builds a red-black tree from values already sorted in ascending order by cmp.
+// The tree is built in O(n) without rotations: it is perfectly balanced, every level is black
+// except an incomplete bottom level, which is red. Equal neighbours are handled according to
+// the DuplicatePolicy in opts. ErrNotSorted is returned if values are out of order.
+func FromSorted[T any](values []T, cmp Comparator[T], opts ...Option) (*Tree[T], error) {
+	tree := NewTree(cmp, opts...)
+
+	kept := make([]T, 0, len(values))
+	for i, v := range values {
+		if i == 0 {
+			kept = append(kept, v)
+			continue
+		}
+
+		c := cmp(values[i-1], v)
+		if c > 0 {
+			return nil, ErrNotSorted
+		}
+		if c == 0 && tree.Duplicates == RejectDuplicates {
+			return nil, ErrDuplicate
+		}
+		if c == 0 && tree.Duplicates == ReplaceDuplicates {
+			kept[len(kept)-1] = v
+			continue
+		}
+		kept = append(kept, v)
+	}
+
+	// Levels above redDepth are complete; any node on the level below them is red.
+	redDepth := bits.Len(uint(len(kept)+1)) - 1
+	tree.Root = tree.build(kept, tree.Nil, 0, redDepth)
+	return tree, nil
+}
+
+// build recursively links the middle of values as the root of a subtree at the given depth.
+func (t *Tree[T]) build(values []T, parent *Node[T], depth, redDepth int) *Node[T] {
+	if len(values) == 0 {
+		return t.Nil
+	}
+
+	mid := len(values) / 2
+	node := NewNode(values[mid])
+	node.Parent = parent
+	node.Color = 'B'
+	if depth == redDepth {
+		node.Color = 'R'
+	}
+	node.Left = t.build(values[:mid], node, depth+1, redDepth)
+	node.Right = t.build(values[mid+1:], node, depth+1, redDepth)
+	t.updateSize(node)
+	return node
+}
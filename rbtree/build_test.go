@@ -0,0 +1,84 @@
+package rbtree
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestFromSorted(t *testing.T) {
+	cmp := func(a, b int) int {
+		return a - b
+	}
+
+	for n := 0; n <= 64; n++ {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = i * 2
+		}
+
+		tree, err := FromSorted(values, cmp)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error: %v", n, err)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Errorf("n=%d: %v", n, err)
+		}
+		if tree.Len() != n {
+			t.Errorf("n=%d: expected Len %d, got %d", n, n, tree.Len())
+		}
+		if got := slices.Collect(tree.Ascend()); n > 0 && !slices.Equal(got, values) {
+			t.Errorf("n=%d: Ascend() = %v, expected %v", n, got, values)
+		}
+
+		// The tree must keep working with the regular update operations.
+		tree.Insert(-1)
+		tree.Insert(2*n + 1)
+		tree.Remove(0)
+		if err := tree.Validate(); err != nil {
+			t.Errorf("n=%d: after updates: %v", n, err)
+		}
+	}
+}
+
+func TestFromSortedRejectsUnsortedInput(t *testing.T) {
+	cmp := func(a, b int) int {
+		return a - b
+	}
+
+	tree, err := FromSorted([]int{1, 3, 2}, cmp)
+	if !errors.Is(err, ErrNotSorted) {
+		t.Errorf("Expected ErrNotSorted, got %v", err)
+	}
+	if tree != nil {
+		t.Errorf("Expected nil tree on error, got %v", tree)
+	}
+}
+
+func TestFromSortedDuplicates(t *testing.T) {
+	type record struct {
+		key  int
+		name string
+	}
+	cmp := func(a, b record) int {
+		return a.key - b.key
+	}
+	values := []record{{1, "a"}, {2, "b"}, {2, "c"}, {3, "d"}}
+
+	multiset, err := FromSorted(values, cmp)
+	if err != nil || multiset.Len() != 4 || multiset.Count(record{key: 2}) != 2 {
+		t.Errorf("Expected multiset with both duplicates, got err=%v", err)
+	}
+
+	if _, err := FromSorted(values, cmp, WithDuplicates(RejectDuplicates)); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("Expected ErrDuplicate, got %v", err)
+	}
+
+	replace, err := FromSorted(values, cmp, WithDuplicates(ReplaceDuplicates))
+	if err != nil || replace.Len() != 3 {
+		t.Fatalf("Expected 3 unique elements, got err=%v", err)
+	}
+	if node := replace.Search(record{key: 2}); node.Val.name != "c" {
+		t.Errorf("Expected last duplicate to win, got %q", node.Val.name)
+	}
+}
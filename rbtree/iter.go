@@ -0,0 +1,61 @@
+package rbtree
+
+import "iter"
+
+// Ascend returns an iterator over all values in the tree in ascending order.
+func (t *Tree[T]) Ascend() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t.Root == t.Nil {
+			return
+		}
+		for node := t.Minimum(t.Root); node != t.Nil; node = t.Successor(node) {
+			if !yield(node.Val) {
+				return
+			}
+		}
+	}
+}
+
+// Descend returns an iterator over all values in the tree in descending order.
+func (t *Tree[T]) Descend() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t.Root == t.Nil {
+			return
+		}
+		for node := t.Maximum(t.Root); node != t.Nil; node = t.Predecessor(node) {
+			if !yield(node.Val) {
+				return
+			}
+		}
+	}
+}
+
+// AscendRange returns an iterator over the values in the half-open range [lo, hi) in ascending order.
+// The first value is located in O(log n) using the tree's Comparator.
+func (t *Tree[T]) AscendRange(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := t.ceiling(lo); node != t.Nil; node = t.Successor(node) {
+			if t.Comparator(node.Val, hi) >= 0 {
+				return
+			}
+			if !yield(node.Val) {
+				return
+			}
+		}
+	}
+}
+
+// DescendRange returns an iterator over the values in the half-open range [lo, hi) in descending order.
+// The first value is located in O(log n) using the tree's Comparator.
+func (t *Tree[T]) DescendRange(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := t.lower(hi); node != t.Nil; node = t.Predecessor(node) {
+			if t.Comparator(node.Val, lo) < 0 {
+				return
+			}
+			if !yield(node.Val) {
+				return
+			}
+		}
+	}
+}
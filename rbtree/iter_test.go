@@ -0,0 +1,119 @@
+package rbtree
+
+import (
+	"slices"
+	"testing"
+)
+
+func newIntTree(values ...int) *Tree[int] {
+	tree := NewTree(func(a, b int) int {
+		return a - b
+	})
+	for _, v := range values {
+		tree.Insert(v)
+	}
+	return tree
+}
+
+func TestAscendAndDescend(t *testing.T) {
+	tree := newIntTree(20, 10, 30, 5, 15, 25, 35)
+
+	ascending := slices.Collect(tree.Ascend())
+	expected := []int{5, 10, 15, 20, 25, 30, 35}
+	if !slices.Equal(ascending, expected) {
+		t.Errorf("Ascend() = %v, expected %v", ascending, expected)
+	}
+
+	descending := slices.Collect(tree.Descend())
+	slices.Reverse(expected)
+	if !slices.Equal(descending, expected) {
+		t.Errorf("Descend() = %v, expected %v", descending, expected)
+	}
+
+	empty := newIntTree()
+	if got := slices.Collect(empty.Ascend()); len(got) != 0 {
+		t.Errorf("Expected no values from empty tree, got %v", got)
+	}
+	if got := slices.Collect(empty.Descend()); len(got) != 0 {
+		t.Errorf("Expected no values from empty tree, got %v", got)
+	}
+}
+
+func TestAscendRange(t *testing.T) {
+	tree := newIntTree(20, 10, 30, 5, 15, 25, 35)
+
+	tests := []struct {
+		name     string
+		lo, hi   int
+		expected []int
+	}{
+		{name: "inner bounds present", lo: 10, hi: 30, expected: []int{10, 15, 20, 25}},
+		{name: "inner bounds absent", lo: 11, hi: 29, expected: []int{15, 20, 25}},
+		{name: "whole tree", lo: 0, hi: 100, expected: []int{5, 10, 15, 20, 25, 30, 35}},
+		{name: "empty range", lo: 16, hi: 19, expected: nil},
+		{name: "lo equals hi", lo: 20, hi: 20, expected: nil},
+		{name: "above maximum", lo: 40, hi: 50, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Collect(tree.AscendRange(tt.lo, tt.hi))
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("AscendRange(%d, %d) = %v, expected %v", tt.lo, tt.hi, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDescendRange(t *testing.T) {
+	tree := newIntTree(20, 10, 30, 5, 15, 25, 35)
+
+	tests := []struct {
+		name     string
+		lo, hi   int
+		expected []int
+	}{
+		{name: "inner bounds present", lo: 10, hi: 30, expected: []int{25, 20, 15, 10}},
+		{name: "inner bounds absent", lo: 11, hi: 29, expected: []int{25, 20, 15}},
+		{name: "whole tree", lo: 0, hi: 100, expected: []int{35, 30, 25, 20, 15, 10, 5}},
+		{name: "empty range", lo: 16, hi: 19, expected: nil},
+		{name: "below minimum", lo: 0, hi: 5, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Collect(tree.DescendRange(tt.lo, tt.hi))
+			if !slices.Equal(got, tt.expected) {
+				t.Errorf("DescendRange(%d, %d) = %v, expected %v", tt.lo, tt.hi, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAscendStopsEarly(t *testing.T) {
+	tree := newIntTree(1, 2, 3, 4, 5, 6, 7, 8)
+
+	var got []int
+	for v := range tree.AscendRange(2, 8) {
+		if v > 4 {
+			break
+		}
+		got = append(got, v)
+	}
+
+	expected := []int{2, 3, 4}
+	if !slices.Equal(got, expected) {
+		t.Errorf("Expected %v before break, got %v", expected, got)
+	}
+
+	got = nil
+	for v := range tree.Descend() {
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{8, 7}) {
+		t.Errorf("Expected [8 7] before break, got %v", got)
+	}
+}
@@ -0,0 +1,154 @@
+package rbtree
+
+import "iter"
+
+// entry is a key/value pair stored in the underlying tree of a Map.
+type entry[K, V any] struct {
+	key K
+	val V
+}
+
+// Map is an ordered key/value map backed by a red-black tree.
+// Keys are unique and ordered by the Comparator given to NewMap.
+type Map[K, V any] struct {
+	tree *Tree[entry[K, V]]
+}
+
+// NewMap creates and returns an empty map whose keys are ordered by the specified comparator function.
+func NewMap[K, V any](cmp Comparator[K]) *Map[K, V] {
+	return &Map[K, V]{
+		tree: NewTree(func(a, b entry[K, V]) int {
+			return cmp(a.key, b.key)
+		}, WithDuplicates(ReplaceDuplicates)),
+	}
+}
+
+// lookup returns the node holding key, or the sentinel Nil node if the key is absent.
+func (m *Map[K, V]) lookup(key K) *Node[entry[K, V]] {
+	return m.tree.Search(entry[K, V]{key: key})
+}
+
+// result unpacks a node into its key and value, reporting false for the sentinel Nil node.
+func (m *Map[K, V]) result(node *Node[entry[K, V]]) (K, V, bool) {
+	if node == m.tree.Nil {
+		var key K
+		var val V
+		return key, val, false
+	}
+	return node.Val.key, node.Val.val, true
+}
+
+// Len returns the number of entries in the map.
+func (m *Map[K, V]) Len() int {
+	return m.tree.Len()
+}
+
+// Get returns the value stored under key and whether the key was present.
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	_, val, ok := m.result(m.lookup(key))
+	return val, ok
+}
+
+// Has reports whether key is present in the map.
+func (m *Map[K, V]) Has(key K) bool {
+	return m.lookup(key) != m.tree.Nil
+}
+
+// Put stores val under key, replacing the previous value if the key is already present.
+func (m *Map[K, V]) Put(key K, val V) {
+	m.tree.Insert(entry[K, V]{key: key, val: val})
+}
+
+// Delete removes key from the map and reports whether it was present.
+func (m *Map[K, V]) Delete(key K) bool {
+	node := m.lookup(key)
+	if node == m.tree.Nil {
+		return false
+	}
+	m.tree.removeNode(node)
+	return true
+}
+
+// Min returns the entry with the smallest key, or false if the map is empty.
+func (m *Map[K, V]) Min() (K, V, bool) {
+	if m.tree.Root == m.tree.Nil {
+		return m.result(m.tree.Nil)
+	}
+	return m.result(m.tree.Minimum(m.tree.Root))
+}
+
+// Max returns the entry with the largest key, or false if the map is empty.
+func (m *Map[K, V]) Max() (K, V, bool) {
+	if m.tree.Root == m.tree.Nil {
+		return m.result(m.tree.Nil)
+	}
+	return m.result(m.tree.Maximum(m.tree.Root))
+}
+
+// Floor returns the entry with the largest key less than or equal to key.
+func (m *Map[K, V]) Floor(key K) (K, V, bool) {
+	return m.result(m.tree.floor(entry[K, V]{key: key}))
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key.
+func (m *Map[K, V]) Ceiling(key K) (K, V, bool) {
+	return m.result(m.tree.ceiling(entry[K, V]{key: key}))
+}
+
+// Lower returns the entry with the largest key strictly less than key.
+func (m *Map[K, V]) Lower(key K) (K, V, bool) {
+	return m.result(m.tree.lower(entry[K, V]{key: key}))
+}
+
+// Higher returns the entry with the smallest key strictly greater than key.
+func (m *Map[K, V]) Higher(key K) (K, V, bool) {
+	return m.result(m.tree.higher(entry[K, V]{key: key}))
+}
+
+// pairs adapts an iterator over entries into an iterator over key/value pairs.
+func pairs[K, V any](seq iter.Seq[entry[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := range seq {
+			if !yield(e.key, e.val) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the map's entries in ascending key order.
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return pairs(m.tree.Ascend())
+}
+
+// Backward returns an iterator over the map's entries in descending key order.
+func (m *Map[K, V]) Backward() iter.Seq2[K, V] {
+	return pairs(m.tree.Descend())
+}
+
+// Range returns an iterator over the entries whose keys fall in the half-open range [lo, hi), in ascending order.
+func (m *Map[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return pairs(m.tree.AscendRange(entry[K, V]{key: lo}, entry[K, V]{key: hi}))
+}
+
+// Keys returns an iterator over the map's keys in ascending order.
+func (m *Map[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range m.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the map's values in ascending key order.
+func (m *Map[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, val := range m.All() {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
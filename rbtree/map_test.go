@@ -0,0 +1,157 @@
+package rbtree
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func newStringMap() *Map[string, int] {
+	return NewMap[string, int](strings.Compare)
+}
+
+func TestMapPutAndGet(t *testing.T) {
+	m := newStringMap()
+
+	if _, ok := m.Get("missing"); ok {
+		t.Error("Expected Get on empty map to report missing key")
+	}
+
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+
+	for key, expected := range map[string]int{"a": 1, "b": 2, "c": 3} {
+		val, ok := m.Get(key)
+		if !ok || val != expected {
+			t.Errorf("Get(%q) = %d, %v; expected %d, true", key, val, ok, expected)
+		}
+	}
+
+	m.Put("b", 20)
+	if val, _ := m.Get("b"); val != 20 {
+		t.Errorf("Expected Put to replace value of existing key, got %d", val)
+	}
+	if m.Len() != 3 {
+		t.Errorf("Expected Len 3 after upsert, got %d", m.Len())
+	}
+}
+
+func TestMapHasAndDelete(t *testing.T) {
+	m := newStringMap()
+	m.Put("x", 1)
+	m.Put("y", 2)
+
+	if !m.Has("x") || m.Has("z") {
+		t.Error("Has reported wrong membership")
+	}
+
+	if !m.Delete("x") {
+		t.Error("Expected Delete to report an existing key")
+	}
+	if m.Delete("x") {
+		t.Error("Expected Delete to report a missing key")
+	}
+	if m.Has("x") {
+		t.Error("Expected key to be gone after Delete")
+	}
+	if m.Len() != 1 {
+		t.Errorf("Expected Len 1 after Delete, got %d", m.Len())
+	}
+}
+
+func TestMapMinAndMax(t *testing.T) {
+	m := NewMap[int, string](func(a, b int) int { return a - b })
+
+	if _, _, ok := m.Min(); ok {
+		t.Error("Expected Min on empty map to report false")
+	}
+	if _, _, ok := m.Max(); ok {
+		t.Error("Expected Max on empty map to report false")
+	}
+
+	m.Put(5, "five")
+	m.Put(1, "one")
+	m.Put(9, "nine")
+
+	if k, v, ok := m.Min(); !ok || k != 1 || v != "one" {
+		t.Errorf("Min() = %d, %q, %v; expected 1, \"one\", true", k, v, ok)
+	}
+	if k, v, ok := m.Max(); !ok || k != 9 || v != "nine" {
+		t.Errorf("Max() = %d, %q, %v; expected 9, \"nine\", true", k, v, ok)
+	}
+}
+
+func TestMapNeighbours(t *testing.T) {
+	m := NewMap[int, int](func(a, b int) int { return a - b })
+	for _, k := range []int{10, 20, 30} {
+		m.Put(k, k*100)
+	}
+
+	tests := []struct {
+		name   string
+		query  func(int) (int, int, bool)
+		key    int
+		want   int
+		wantOk bool
+	}{
+		{name: "floor exact", query: m.Floor, key: 20, want: 20, wantOk: true},
+		{name: "floor between", query: m.Floor, key: 25, want: 20, wantOk: true},
+		{name: "floor below", query: m.Floor, key: 5, wantOk: false},
+		{name: "ceiling exact", query: m.Ceiling, key: 20, want: 20, wantOk: true},
+		{name: "ceiling between", query: m.Ceiling, key: 25, want: 30, wantOk: true},
+		{name: "ceiling above", query: m.Ceiling, key: 35, wantOk: false},
+		{name: "lower exact", query: m.Lower, key: 20, want: 10, wantOk: true},
+		{name: "lower below", query: m.Lower, key: 10, wantOk: false},
+		{name: "higher exact", query: m.Higher, key: 20, want: 30, wantOk: true},
+		{name: "higher above", query: m.Higher, key: 30, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, v, ok := tt.query(tt.key)
+			if ok != tt.wantOk {
+				t.Fatalf("Expected ok=%v, got %v", tt.wantOk, ok)
+			}
+			if ok && (k != tt.want || v != tt.want*100) {
+				t.Errorf("Expected %d => %d, got %d => %d", tt.want, tt.want*100, k, v)
+			}
+		})
+	}
+}
+
+func TestMapIteration(t *testing.T) {
+	m := newStringMap()
+	for i, key := range []string{"d", "b", "a", "c", "e"} {
+		m.Put(key, i)
+	}
+
+	keys := slices.Collect(m.Keys())
+	if !slices.Equal(keys, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("Keys() = %v, expected sorted keys", keys)
+	}
+
+	values := slices.Collect(m.Values())
+	if !slices.Equal(values, []int{2, 1, 3, 0, 4}) {
+		t.Errorf("Values() = %v, expected values in key order", values)
+	}
+
+	var backward []string
+	for key := range m.Backward() {
+		backward = append(backward, key)
+	}
+	if !slices.Equal(backward, []string{"e", "d", "c", "b", "a"}) {
+		t.Errorf("Backward() = %v, expected reverse key order", backward)
+	}
+
+	var ranged []string
+	for key, val := range m.Range("b", "d") {
+		ranged = append(ranged, key)
+		if got, _ := m.Get(key); got != val {
+			t.Errorf("Range yielded value %d for %q, expected %d", val, key, got)
+		}
+	}
+	if !slices.Equal(ranged, []string{"b", "c"}) {
+		t.Errorf("Range(b, d) = %v, expected [b c]", ranged)
+	}
+}
@@ -0,0 +1,43 @@
+package rbtree
+
+// Select returns the node holding the k-th smallest value in the tree, counting from zero.
+// It returns the sentinel Nil node if k is out of range. The lookup runs in O(log n).
+func (t *Tree[T]) Select(k int) *Node[T] {
+	if k < 0 || k >= t.Len() {
+		return t.Nil
+	}
+
+	current := t.Root
+	for current != t.Nil {
+		leftSize := size(current.Left)
+		if k == leftSize {
+			return current
+		}
+		if k < leftSize {
+			current = current.Left
+		} else {
+			k -= leftSize + 1
+			current = current.Right
+		}
+	}
+
+	return t.Nil
+}
+
+// Rank returns the number of elements in the tree that are strictly less than elem.
+// The element itself does not need to be present. The lookup runs in O(log n).
+func (t *Tree[T]) Rank(elem T) int {
+	rank := 0
+	current := t.Root
+
+	for current != t.Nil {
+		if t.Comparator(elem, current.Val) <= 0 {
+			current = current.Left
+		} else {
+			rank += size(current.Left) + 1
+			current = current.Right
+		}
+	}
+
+	return rank
+}
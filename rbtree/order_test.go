@@ -0,0 +1,105 @@
+package rbtree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSelectAndRank(t *testing.T) {
+	tree := newIntTree(50, 25, 75, 10, 30, 60, 80, 5, 15, 27)
+	sorted := []int{5, 10, 15, 25, 27, 30, 50, 60, 75, 80}
+
+	if tree.Len() != len(sorted) {
+		t.Fatalf("Expected Len %d, got %d", len(sorted), tree.Len())
+	}
+
+	for k, v := range sorted {
+		node := tree.Select(k)
+		if node == tree.Nil || node.Val != v {
+			t.Errorf("Select(%d): expected %d, got %v", k, v, node)
+		}
+		if rank := tree.Rank(v); rank != k {
+			t.Errorf("Rank(%d): expected %d, got %d", v, k, rank)
+		}
+	}
+
+	if node := tree.Select(-1); node != tree.Nil {
+		t.Errorf("Expected Nil for Select(-1), got %v", node)
+	}
+	if node := tree.Select(len(sorted)); node != tree.Nil {
+		t.Errorf("Expected Nil for Select(%d), got %v", len(sorted), node)
+	}
+
+	if rank := tree.Rank(0); rank != 0 {
+		t.Errorf("Rank(0): expected 0, got %d", rank)
+	}
+	if rank := tree.Rank(26); rank != 4 {
+		t.Errorf("Rank(26): expected 4, got %d", rank)
+	}
+	if rank := tree.Rank(100); rank != len(sorted) {
+		t.Errorf("Rank(100): expected %d, got %d", len(sorted), rank)
+	}
+}
+
+func TestLenAfterRemove(t *testing.T) {
+	tree := newIntTree()
+	if tree.Len() != 0 {
+		t.Errorf("Expected empty tree to have Len 0, got %d", tree.Len())
+	}
+
+	values := []int{20, 10, 30, 5, 15, 25, 35}
+	for _, v := range values {
+		tree.Insert(v)
+	}
+
+	tree.Remove(42)
+	if tree.Len() != len(values) {
+		t.Errorf("Expected Len %d after removing a missing value, got %d", len(values), tree.Len())
+	}
+
+	for i, v := range values {
+		tree.Remove(v)
+		if tree.Len() != len(values)-i-1 {
+			t.Errorf("Expected Len %d after removing %d, got %d", len(values)-i-1, v, tree.Len())
+		}
+	}
+}
+
+func TestSubtreeSizesAfterRandomOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tree := newIntTree()
+
+	var checkSizes func(node *Node[int]) int
+	checkSizes = func(node *Node[int]) int {
+		if node == tree.Nil {
+			return 0
+		}
+		n := checkSizes(node.Left) + checkSizes(node.Right) + 1
+		if node.size != n {
+			t.Fatalf("Node %d: expected subtree size %d, got %d", node.Val, n, node.size)
+		}
+		return n
+	}
+
+	for i := 0; i < 2000; i++ {
+		v := rng.Intn(200)
+		if rng.Intn(3) == 0 {
+			tree.Remove(v)
+		} else {
+			tree.Insert(v)
+		}
+		checkSizes(tree.Root)
+	}
+
+	if tree.Nil.size != 0 {
+		t.Errorf("Expected sentinel Nil size to stay 0, got %d", tree.Nil.size)
+	}
+
+	k := 0
+	for v := range tree.Ascend() {
+		if node := tree.Select(k); node.Val != v {
+			t.Fatalf("Select(%d): expected %d, got %d", k, v, node.Val)
+		}
+		k++
+	}
+}
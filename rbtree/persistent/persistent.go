@@ -0,0 +1,202 @@
+// Package persistent implements an immutable red-black tree.
+//
+// Insert and Remove never modify a tree; they return a new tree that shares every
+// unchanged subtree with the old one (path copying), so any number of goroutines can
+// read a snapshot while a writer keeps deriving new versions from it. Nodes have no
+// parent pointers and there is no shared sentinel node, which is what makes sharing safe.
+package persistent
+
+import "github.com/codeYann/go-collections/rbtree"
+
+// node is an immutable node of a persistent red-black tree.
+// The Color field follows rbtree.Node: 'R' for red and 'B' for black. A nil node is a black leaf.
+type node[T any] struct {
+	val   T
+	left  *node[T]
+	right *node[T]
+	color byte
+	size  int
+}
+
+// Tree is an immutable red-black tree ordered by a Comparator.
+// The zero value is not usable; create trees with NewTree.
+type Tree[T any] struct {
+	root *node[T]
+	cmp  rbtree.Comparator[T]
+}
+
+// NewTree creates and returns an empty persistent red-black tree with the specified comparator function.
+func NewTree[T any](cmp rbtree.Comparator[T]) *Tree[T] {
+	return &Tree[T]{cmp: cmp}
+}
+
+// with returns a tree sharing t's comparator with the given root.
+func (t *Tree[T]) with(root *node[T]) *Tree[T] {
+	return &Tree[T]{root: root, cmp: t.cmp}
+}
+
+// newNode allocates a node and computes its subtree size from its children.
+func newNode[T any](color byte, left *node[T], val T, right *node[T]) *node[T] {
+	return &node[T]{val: val, left: left, right: right, color: color, size: size(left) + size(right) + 1}
+}
+
+// size returns the number of nodes in the subtree rooted at n.
+func size[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func isRed[T any](n *node[T]) bool {
+	return n != nil && n.color == 'R'
+}
+
+func isBlack[T any](n *node[T]) bool {
+	return n != nil && n.color == 'B'
+}
+
+// blacken returns n colored black, copying it only if it was red.
+func blacken[T any](n *node[T]) *node[T] {
+	if n == nil || n.color == 'B' {
+		return n
+	}
+	return newNode('B', n.left, n.val, n.right)
+}
+
+// redden returns a red copy of the black node n. It is only valid on black nodes.
+func redden[T any](n *node[T]) *node[T] {
+	if !isBlack(n) {
+		panic("persistent: red-black invariant violated")
+	}
+	return newNode('R', n.left, n.val, n.right)
+}
+
+// balance builds a black node from a, x and b, resolving any red-red violation
+// one level below it by rotating into a red node with two black children.
+func balance[T any](a *node[T], x T, b *node[T]) *node[T] {
+	switch {
+	case isRed(a) && isRed(b):
+		return newNode('R', blacken(a), x, blacken(b))
+	case isRed(a) && isRed(a.left):
+		return newNode('R', blacken(a.left), a.val, newNode('B', a.right, x, b))
+	case isRed(a) && isRed(a.right):
+		return newNode('R', newNode('B', a.left, a.val, a.right.left), a.right.val, newNode('B', a.right.right, x, b))
+	case isRed(b) && isRed(b.right):
+		return newNode('R', newNode('B', a, x, b.left), b.val, blacken(b.right))
+	case isRed(b) && isRed(b.left):
+		return newNode('R', newNode('B', a, x, b.left.left), b.left.val, newNode('B', b.left.right, b.val, b.right))
+	}
+	return newNode('B', a, x, b)
+}
+
+// insert returns a copy of the subtree rooted at n with elem added.
+// Equal elements go to the right, so the tree behaves as a multiset like rbtree.Tree.
+func (t *Tree[T]) insert(n *node[T], elem T) *node[T] {
+	if n == nil {
+		return newNode('R', nil, elem, nil)
+	}
+
+	if t.cmp(elem, n.val) < 0 {
+		if n.color == 'B' {
+			return balance(t.insert(n.left, elem), n.val, n.right)
+		}
+		return newNode('R', t.insert(n.left, elem), n.val, n.right)
+	}
+
+	if n.color == 'B' {
+		return balance(n.left, n.val, t.insert(n.right, elem))
+	}
+	return newNode('R', n.left, n.val, t.insert(n.right, elem))
+}
+
+// Insert returns a new tree containing elem in addition to the elements of t.
+// t itself is not modified. It runs in O(log n) time and allocates O(log n) nodes.
+func (t *Tree[T]) Insert(elem T) *Tree[T] {
+	return t.with(blacken(t.insert(t.root, elem)))
+}
+
+// balanceLeft rebuilds a node whose left subtree l has a black height one less than its right subtree r.
+func balanceLeft[T any](l *node[T], x T, r *node[T]) *node[T] {
+	switch {
+	case isRed(l):
+		return newNode('R', blacken(l), x, r)
+	case isBlack(r):
+		return balance(l, x, redden(r))
+	case isRed(r) && isBlack(r.left):
+		return newNode('R', newNode('B', l, x, r.left.left), r.left.val, balance(r.left.right, r.val, redden(r.right)))
+	}
+	panic("persistent: red-black invariant violated")
+}
+
+// balanceRight rebuilds a node whose right subtree r has a black height one less than its left subtree l.
+func balanceRight[T any](l *node[T], x T, r *node[T]) *node[T] {
+	switch {
+	case isRed(r):
+		return newNode('R', l, x, blacken(r))
+	case isBlack(l):
+		return balance(redden(l), x, r)
+	case isRed(l) && isBlack(l.right):
+		return newNode('R', balance(redden(l.left), l.val, l.right.left), l.right.val, newNode('B', l.right.right, x, r))
+	}
+	panic("persistent: red-black invariant violated")
+}
+
+// fuse joins two subtrees of equal black height whose elements are ordered a before b,
+// replacing the node that used to separate them.
+func fuse[T any](a, b *node[T]) *node[T] {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case isRed(a) && isRed(b):
+		mid := fuse(a.right, b.left)
+		if isRed(mid) {
+			return newNode('R', newNode('R', a.left, a.val, mid.left), mid.val, newNode('R', mid.right, b.val, b.right))
+		}
+		return newNode('R', a.left, a.val, newNode('R', mid, b.val, b.right))
+	case isBlack(a) && isBlack(b):
+		mid := fuse(a.right, b.left)
+		if isRed(mid) {
+			return newNode('R', newNode('B', a.left, a.val, mid.left), mid.val, newNode('B', mid.right, b.val, b.right))
+		}
+		return balanceLeft(a.left, a.val, newNode('B', mid, b.val, b.right))
+	case isRed(b):
+		return newNode('R', fuse(a, b.left), b.val, b.right)
+	default:
+		return newNode('R', a.left, a.val, fuse(a.right, b))
+	}
+}
+
+// remove returns a copy of the subtree rooted at n without one element equal to elem.
+// elem must be present in the subtree.
+func (t *Tree[T]) remove(n *node[T], elem T) *node[T] {
+	if n == nil {
+		return nil
+	}
+
+	c := t.cmp(elem, n.val)
+	if c < 0 {
+		if isBlack(n.left) {
+			return balanceLeft(t.remove(n.left, elem), n.val, n.right)
+		}
+		return newNode('R', t.remove(n.left, elem), n.val, n.right)
+	}
+	if c > 0 {
+		if isBlack(n.right) {
+			return balanceRight(n.left, n.val, t.remove(n.right, elem))
+		}
+		return newNode('R', n.left, n.val, t.remove(n.right, elem))
+	}
+	return fuse(n.left, n.right)
+}
+
+// Remove returns a new tree without one element equal to elem.
+// If no such element exists, t itself is returned. t is never modified.
+func (t *Tree[T]) Remove(elem T) *Tree[T] {
+	if _, ok := t.Search(elem); !ok {
+		return t
+	}
+	return t.with(blacken(t.remove(t.root, elem)))
+}
@@ -0,0 +1,133 @@
+package persistent
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func intCmp(a, b int) int {
+	return a - b
+}
+
+func TestInsertAndRemove(t *testing.T) {
+	tree := NewTree(intCmp)
+	for _, v := range []int{20, 10, 30, 5, 15, 25, 35} {
+		tree = tree.Insert(v)
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("After inserting %d: %v", v, err)
+		}
+	}
+
+	if got := slices.Collect(tree.Ascend()); !slices.Equal(got, []int{5, 10, 15, 20, 25, 30, 35}) {
+		t.Errorf("Ascend() = %v", got)
+	}
+
+	for _, v := range []int{5, 30, 20} {
+		tree = tree.Remove(v)
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("After removing %d: %v", v, err)
+		}
+		if _, ok := tree.Search(v); ok {
+			t.Errorf("Expected %d to be removed", v)
+		}
+	}
+
+	if got := slices.Collect(tree.Ascend()); !slices.Equal(got, []int{10, 15, 25, 35}) {
+		t.Errorf("Ascend() after removals = %v", got)
+	}
+}
+
+func TestRemoveMissingReturnsSameTree(t *testing.T) {
+	tree := NewTree(intCmp).Insert(1).Insert(2)
+	if tree.Remove(42) != tree {
+		t.Error("Expected removing a missing element to return the same tree")
+	}
+}
+
+func TestOldVersionsAreUnchanged(t *testing.T) {
+	versions := []*Tree[int]{NewTree(intCmp)}
+	for i := range 100 {
+		versions = append(versions, versions[len(versions)-1].Insert(i))
+	}
+	for i := range 100 {
+		versions = append(versions, versions[len(versions)-1].Remove(i))
+	}
+
+	for i, tree := range versions {
+		expectedLen := i
+		if i > 100 {
+			expectedLen = 200 - i
+		}
+		if tree.Len() != expectedLen {
+			t.Fatalf("Version %d: expected Len %d, got %d", i, expectedLen, tree.Len())
+		}
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("Version %d: %v", i, err)
+		}
+	}
+}
+
+func TestRandomOperationsAgainstSortedSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	tree := NewTree(intCmp)
+	var expected []int
+
+	for i := 0; i < 3000; i++ {
+		v := rng.Intn(300)
+		if rng.Intn(3) == 0 {
+			tree = tree.Remove(v)
+			if idx, ok := slices.BinarySearch(expected, v); ok {
+				expected = slices.Delete(expected, idx, idx+1)
+			}
+		} else {
+			tree = tree.Insert(v)
+			idx, _ := slices.BinarySearch(expected, v)
+			expected = slices.Insert(expected, idx, v)
+		}
+
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("Step %d: %v", i, err)
+		}
+	}
+
+	if got := slices.Collect(tree.Ascend()); !slices.Equal(got, expected) {
+		t.Errorf("Ascend() = %v, expected %v", got, expected)
+	}
+}
+
+func TestConcurrentReadersWithWriter(t *testing.T) {
+	tree := NewTree(intCmp)
+	for i := range 1000 {
+		tree = tree.Insert(i)
+	}
+	snapshot := tree
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				sum := 0
+				for v := range snapshot.Ascend() {
+					sum += v
+				}
+				if sum != 999*1000/2 {
+					t.Errorf("Snapshot changed underneath a reader: sum %d", sum)
+					return
+				}
+			}
+		}()
+	}
+
+	for i := range 1000 {
+		tree = tree.Remove(i).Insert(i + 1000)
+	}
+	wg.Wait()
+
+	if snapshot.Len() != 1000 || tree.Len() != 1000 {
+		t.Errorf("Expected both versions to hold 1000 elements, got %d and %d", snapshot.Len(), tree.Len())
+	}
+}
@@ -0,0 +1,322 @@
+package persistent
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// Len returns the number of elements stored in the tree in O(1).
+func (t *Tree[T]) Len() int {
+	return size(t.root)
+}
+
+// Height returns the height of the tree. The height of an empty tree is -1.
+func (t *Tree[T]) Height() int {
+	var height func(n *node[T]) int
+	height = func(n *node[T]) int {
+		if n == nil {
+			return -1
+		}
+		return 1 + max(height(n.left), height(n.right))
+	}
+	return height(t.root)
+}
+
+// Search looks for an element equal to elem and reports whether it was found.
+func (t *Tree[T]) Search(elem T) (T, bool) {
+	current := t.root
+
+	for current != nil {
+		cmp := t.cmp(elem, current.val)
+		if cmp == 0 {
+			return current.val, true
+		}
+		if cmp < 0 {
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// found unpacks a possibly nil node into its value and whether it exists.
+func found[T any](n *node[T]) (T, bool) {
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+	return n.val, true
+}
+
+// Minimum returns the smallest element in the tree, or false if the tree is empty.
+func (t *Tree[T]) Minimum() (T, bool) {
+	current := t.root
+	for current != nil && current.left != nil {
+		current = current.left
+	}
+	return found(current)
+}
+
+// Maximum returns the largest element in the tree, or false if the tree is empty.
+func (t *Tree[T]) Maximum() (T, bool) {
+	current := t.root
+	for current != nil && current.right != nil {
+		current = current.right
+	}
+	return found(current)
+}
+
+// Floor returns the largest element less than or equal to elem, or false if no such element exists.
+func (t *Tree[T]) Floor(elem T) (T, bool) {
+	var result *node[T]
+	for current := t.root; current != nil; {
+		if t.cmp(elem, current.val) >= 0 {
+			result = current
+			current = current.right
+		} else {
+			current = current.left
+		}
+	}
+	return found(result)
+}
+
+// Ceiling returns the smallest element greater than or equal to elem, or false if no such element exists.
+func (t *Tree[T]) Ceiling(elem T) (T, bool) {
+	var result *node[T]
+	for current := t.root; current != nil; {
+		if t.cmp(elem, current.val) <= 0 {
+			result = current
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+	return found(result)
+}
+
+// Lower returns the largest element strictly less than elem, or false if no such element exists.
+func (t *Tree[T]) Lower(elem T) (T, bool) {
+	var result *node[T]
+	for current := t.root; current != nil; {
+		if t.cmp(elem, current.val) > 0 {
+			result = current
+			current = current.right
+		} else {
+			current = current.left
+		}
+	}
+	return found(result)
+}
+
+// Higher returns the smallest element strictly greater than elem, or false if no such element exists.
+func (t *Tree[T]) Higher(elem T) (T, bool) {
+	var result *node[T]
+	for current := t.root; current != nil; {
+		if t.cmp(elem, current.val) < 0 {
+			result = current
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+	return found(result)
+}
+
+// Select returns the k-th smallest element in the tree, counting from zero, or false if k is out of range.
+func (t *Tree[T]) Select(k int) (T, bool) {
+	if k < 0 || k >= t.Len() {
+		return found[T](nil)
+	}
+
+	current := t.root
+	for current != nil {
+		leftSize := size(current.left)
+		if k == leftSize {
+			break
+		}
+		if k < leftSize {
+			current = current.left
+		} else {
+			k -= leftSize + 1
+			current = current.right
+		}
+	}
+	return found(current)
+}
+
+// Rank returns the number of elements in the tree that are strictly less than elem.
+func (t *Tree[T]) Rank(elem T) int {
+	rank := 0
+	for current := t.root; current != nil; {
+		if t.cmp(elem, current.val) <= 0 {
+			current = current.left
+		} else {
+			rank += size(current.left) + 1
+			current = current.right
+		}
+	}
+	return rank
+}
+
+// Count returns the number of elements in the tree equal to elem.
+func (t *Tree[T]) Count(elem T) int {
+	atMost := 0
+	for current := t.root; current != nil; {
+		if t.cmp(elem, current.val) < 0 {
+			current = current.left
+		} else {
+			atMost += size(current.left) + 1
+			current = current.right
+		}
+	}
+	return atMost - t.Rank(elem)
+}
+
+// ascend yields, in ascending order, the elements for which from reports true, stopping at the
+// first element for which to reports false. from must be monotone: false for a prefix, then true.
+func (t *Tree[T]) ascend(from, to func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var stack []*node[T]
+		for current := t.root; current != nil; {
+			if from(current.val) {
+				stack = append(stack, current)
+				current = current.left
+			} else {
+				current = current.right
+			}
+		}
+
+		for len(stack) > 0 {
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !to(n.val) || !yield(n.val) {
+				return
+			}
+			for current := n.right; current != nil; current = current.left {
+				stack = append(stack, current)
+			}
+		}
+	}
+}
+
+// descend yields, in descending order, the elements for which from reports true, stopping at the
+// first element for which to reports false. from must be monotone: true for a prefix, then false.
+func (t *Tree[T]) descend(from, to func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var stack []*node[T]
+		for current := t.root; current != nil; {
+			if from(current.val) {
+				stack = append(stack, current)
+				current = current.right
+			} else {
+				current = current.left
+			}
+		}
+
+		for len(stack) > 0 {
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !to(n.val) || !yield(n.val) {
+				return
+			}
+			for current := n.left; current != nil; current = current.right {
+				stack = append(stack, current)
+			}
+		}
+	}
+}
+
+func always[T any](T) bool {
+	return true
+}
+
+// Ascend returns an iterator over all elements in the tree in ascending order.
+func (t *Tree[T]) Ascend() iter.Seq[T] {
+	return t.ascend(always[T], always[T])
+}
+
+// Descend returns an iterator over all elements in the tree in descending order.
+func (t *Tree[T]) Descend() iter.Seq[T] {
+	return t.descend(always[T], always[T])
+}
+
+// AscendRange returns an iterator over the elements in the half-open range [lo, hi) in ascending order.
+func (t *Tree[T]) AscendRange(lo, hi T) iter.Seq[T] {
+	return t.ascend(
+		func(v T) bool { return t.cmp(v, lo) >= 0 },
+		func(v T) bool { return t.cmp(v, hi) < 0 },
+	)
+}
+
+// DescendRange returns an iterator over the elements in the half-open range [lo, hi) in descending order.
+func (t *Tree[T]) DescendRange(lo, hi T) iter.Seq[T] {
+	return t.descend(
+		func(v T) bool { return t.cmp(v, hi) < 0 },
+		func(v T) bool { return t.cmp(v, lo) >= 0 },
+	)
+}
+
+// Validate checks that the tree satisfies the red-black invariants, that elements are in order
+// according to the comparator and that cached subtree sizes are correct. It returns an error
+// describing the first violation, with the path from the root to the offending node.
+func (t *Tree[T]) Validate() error {
+	if isRed(t.root) {
+		return fmt.Errorf("root is R, expected B")
+	}
+
+	var prev *node[T]
+	path := []string{"root"}
+
+	var check func(n *node[T]) (int, error)
+	check = func(n *node[T]) (int, error) {
+		if n == nil {
+			return 0, nil
+		}
+
+		at := strings.Join(path, ".")
+		if n.color != 'R' && n.color != 'B' {
+			return 0, fmt.Errorf("node at %s: invalid color %q", at, n.color)
+		}
+		if isRed(n) && (isRed(n.left) || isRed(n.right)) {
+			return 0, fmt.Errorf("node at %s: red node has a red child", at)
+		}
+
+		path = append(path, "L")
+		left, err := check(n.left)
+		path = path[:len(path)-1]
+		if err != nil {
+			return 0, err
+		}
+
+		if prev != nil && t.cmp(prev.val, n.val) > 0 {
+			return 0, fmt.Errorf("node at %s: value is out of order with its in-order predecessor", at)
+		}
+		prev = n
+
+		path = append(path, "R")
+		right, err := check(n.right)
+		path = path[:len(path)-1]
+		if err != nil {
+			return 0, err
+		}
+
+		if left != right {
+			return 0, fmt.Errorf("node at %s: black height of left subtree (%d) differs from right subtree (%d)", at, left, right)
+		}
+		if expected := size(n.left) + size(n.right) + 1; n.size != expected {
+			return 0, fmt.Errorf("node at %s: subtree size is %d, expected %d", at, n.size, expected)
+		}
+
+		if n.color == 'B' {
+			left++
+		}
+		return left, nil
+	}
+
+	_, err := check(t.root)
+	return err
+}
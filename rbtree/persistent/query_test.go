@@ -0,0 +1,117 @@
+package persistent
+
+import (
+	"slices"
+	"testing"
+)
+
+func newIntTree(values ...int) *Tree[int] {
+	tree := NewTree(intCmp)
+	for _, v := range values {
+		tree = tree.Insert(v)
+	}
+	return tree
+}
+
+func TestEmptyTreeQueries(t *testing.T) {
+	tree := newIntTree()
+
+	if tree.Len() != 0 || tree.Height() != -1 {
+		t.Errorf("Expected Len 0 and Height -1, got %d and %d", tree.Len(), tree.Height())
+	}
+	if _, ok := tree.Minimum(); ok {
+		t.Error("Expected Minimum on empty tree to report false")
+	}
+	if _, ok := tree.Maximum(); ok {
+		t.Error("Expected Maximum on empty tree to report false")
+	}
+	if _, ok := tree.Select(0); ok {
+		t.Error("Expected Select on empty tree to report false")
+	}
+	if got := slices.Collect(tree.Ascend()); len(got) != 0 {
+		t.Errorf("Expected no values, got %v", got)
+	}
+}
+
+func TestOrderQueries(t *testing.T) {
+	tree := newIntTree(20, 10, 30, 5, 15, 25, 35)
+
+	if v, ok := tree.Minimum(); !ok || v != 5 {
+		t.Errorf("Minimum() = %d, %v", v, ok)
+	}
+	if v, ok := tree.Maximum(); !ok || v != 35 {
+		t.Errorf("Maximum() = %d, %v", v, ok)
+	}
+
+	tests := []struct {
+		name   string
+		query  func(int) (int, bool)
+		elem   int
+		want   int
+		wantOk bool
+	}{
+		{name: "floor between", query: tree.Floor, elem: 24, want: 20, wantOk: true},
+		{name: "floor below minimum", query: tree.Floor, elem: 1, wantOk: false},
+		{name: "ceiling between", query: tree.Ceiling, elem: 16, want: 20, wantOk: true},
+		{name: "ceiling above maximum", query: tree.Ceiling, elem: 36, wantOk: false},
+		{name: "lower exact", query: tree.Lower, elem: 15, want: 10, wantOk: true},
+		{name: "higher exact", query: tree.Higher, elem: 15, want: 20, wantOk: true},
+		{name: "higher maximum", query: tree.Higher, elem: 35, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.query(tt.elem)
+			if ok != tt.wantOk || (ok && got != tt.want) {
+				t.Errorf("Expected %d, %v; got %d, %v", tt.want, tt.wantOk, got, ok)
+			}
+		})
+	}
+
+	sorted := []int{5, 10, 15, 20, 25, 30, 35}
+	for k, v := range sorted {
+		if got, ok := tree.Select(k); !ok || got != v {
+			t.Errorf("Select(%d) = %d, %v; expected %d", k, got, ok, v)
+		}
+		if rank := tree.Rank(v); rank != k {
+			t.Errorf("Rank(%d) = %d, expected %d", v, rank, k)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	tree := newIntTree(5, 3, 5, 8, 5, 1)
+	if count := tree.Count(5); count != 3 {
+		t.Errorf("Expected Count 3, got %d", count)
+	}
+	if count := tree.Remove(5).Count(5); count != 2 {
+		t.Errorf("Expected Count 2 after one Remove, got %d", count)
+	}
+	if count := tree.Count(4); count != 0 {
+		t.Errorf("Expected Count 0, got %d", count)
+	}
+}
+
+func TestIterators(t *testing.T) {
+	tree := newIntTree(20, 10, 30, 5, 15, 25, 35)
+
+	if got := slices.Collect(tree.Descend()); !slices.Equal(got, []int{35, 30, 25, 20, 15, 10, 5}) {
+		t.Errorf("Descend() = %v", got)
+	}
+	if got := slices.Collect(tree.AscendRange(11, 30)); !slices.Equal(got, []int{15, 20, 25}) {
+		t.Errorf("AscendRange(11, 30) = %v", got)
+	}
+	if got := slices.Collect(tree.DescendRange(10, 26)); !slices.Equal(got, []int{25, 20, 15, 10}) {
+		t.Errorf("DescendRange(10, 26) = %v", got)
+	}
+
+	var got []int
+	for v := range tree.Ascend() {
+		if v > 15 {
+			break
+		}
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{5, 10, 15}) {
+		t.Errorf("Expected [5 10 15] before break, got %v", got)
+	}
+}
@@ -1,40 +1,94 @@
 package rbtree
 
+import "errors"
+
 // Node represents a node in the red-black tree.
 // It holds a value of generic type T and pointers to its parent, left, and right children.
 // The Color field indicates whether the node is red ('R') or black ('B').
+// The node also tracks the number of nodes in its subtree to support order-statistic queries.
 type Node[T any] struct {
 	Val    T
 	Left   *Node[T]
 	Right  *Node[T]
 	Parent *Node[T]
 	Color  byte // 'R' for Red, 'B' for Black
+	size   int
 }
 
 // Comparator defines a function type for comparing two values of type T.
 // It returns a negative value if a < b, zero if a == b, and a positive value if a > b.
 type Comparator[T any] func(a, b T) int
 
+// DuplicatePolicy determines how Insert handles an element equal to one already in the tree.
+type DuplicatePolicy int
+
+const (
+	// AllowDuplicates stores equal elements side by side, so the tree behaves as a multiset.
+	AllowDuplicates DuplicatePolicy = iota
+	// RejectDuplicates makes Insert return ErrDuplicate and leave the tree unchanged.
+	RejectDuplicates
+	// ReplaceDuplicates overwrites the stored element in place with the inserted one.
+	ReplaceDuplicates
+)
+
+// ErrDuplicate is returned by Insert when the tree rejects an element equal to an existing one.
+var ErrDuplicate = errors.New("element already exists")
+
+// Option configures a tree created by NewTree.
+type Option func(*options)
+
+type options struct {
+	duplicates DuplicatePolicy
+}
+
+// WithDuplicates selects the policy Insert applies to elements equal to an existing one.
+// Trees allow duplicates by default.
+func WithDuplicates(policy DuplicatePolicy) Option {
+	return func(o *options) {
+		o.duplicates = policy
+	}
+}
+
 // Tree represents a red-black tree data structure.
-// It maintains the root node, a comparator function, and a sentinel Nil node.
+// It maintains the root node, a comparator function, a sentinel Nil node and the policy for duplicate elements.
 type Tree[T any] struct {
 	Root       *Node[T]
 	Comparator Comparator[T]
 	Nil        *Node[T]
+	Duplicates DuplicatePolicy
 }
 
 // NewNode creates and returns a new red node with the given value.
 func NewNode[T any](val T) *Node[T] {
-	return &Node[T]{Val: val, Color: 'R'}
+	return &Node[T]{Val: val, Color: 'R', size: 1}
 }
 
-// NewTree creates and returns a new red-black tree with the specified comparator function.
-func NewTree[T any](cmp Comparator[T]) *Tree[T] {
+// NewTree creates and returns a new red-black tree with the specified comparator function and options.
+func NewTree[T any](cmp Comparator[T], opts ...Option) *Tree[T] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	var Nil *Node[T] = &Node[T]{Color: 'B'} // define a sentinel Nil node
 	Nil.Parent = Nil
 	Nil.Left = Nil
 	Nil.Right = Nil
-	return &Tree[T]{Root: Nil, Comparator: cmp, Nil: Nil}
+	return &Tree[T]{Root: Nil, Comparator: cmp, Nil: Nil, Duplicates: o.duplicates}
+}
+
+// size returns the number of nodes in the subtree rooted at node.
+// The sentinel Nil node (and a nil pointer) always has size zero.
+func size[T any](node *Node[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+// updateSize recomputes the subtree size of node from its children.
+func (t *Tree[T]) updateSize(node *Node[T]) {
+	node.size = size(node.Left) + size(node.Right) + 1
 }
 
 // RotateLeft performs a left rotation on the given node x.
@@ -55,6 +109,9 @@ func (t *Tree[T]) RotateLeft(x *Node[T]) {
 	}
 	y.Left = x
 	x.Parent = y
+
+	t.updateSize(x)
+	t.updateSize(y)
 }
 
 // RotateRight performs a right rotation on the given node y.
@@ -77,12 +134,16 @@ func (t *Tree[T]) RotateRight(y *Node[T]) {
 
 	x.Right = y
 	y.Parent = x
+
+	t.updateSize(y)
+	t.updateSize(x)
 }
 
 // insertFixup restores red-black tree properties after insertion.
 // It handles violations of the red-black properties by recoloring nodes and performing rotations.
 // The function continues until the tree satisfies all red-black properties.
-func (t *Tree[T]) insertFixup(z *Node[T]) {
+// It reports whether the root had to be recolored black, which raises the tree's black height by one.
+func (t *Tree[T]) insertFixup(z *Node[T]) bool {
 	for z.Parent != t.Nil && z.Parent.Color == 'R' {
 		if z.Parent.Parent == t.Nil {
 			break
@@ -122,11 +183,15 @@ func (t *Tree[T]) insertFixup(z *Node[T]) {
 			}
 		}
 	}
+	grew := t.Root.Color == 'R'
 	t.Root.Color = 'B'
+	return grew
 }
 
 // Insert adds a new element to the red-black tree while maintaining its properties.
-func (t *Tree[T]) Insert(elem T) {
+// Elements equal to an existing one are handled according to the tree's DuplicatePolicy;
+// ErrDuplicate is returned when the policy is RejectDuplicates.
+func (t *Tree[T]) Insert(elem T) error {
 	node := NewNode(elem)
 
 	if t.Root == t.Nil {
@@ -135,7 +200,7 @@ func (t *Tree[T]) Insert(elem T) {
 		t.Root.Parent = t.Nil
 		t.Root.Left = t.Nil
 		t.Root.Right = t.Nil
-		return
+		return nil
 	}
 
 	current := t.Root
@@ -143,6 +208,14 @@ func (t *Tree[T]) Insert(elem T) {
 	for {
 		cmp := t.Comparator(elem, current.Val)
 
+		if cmp == 0 && t.Duplicates == RejectDuplicates {
+			return ErrDuplicate
+		}
+		if cmp == 0 && t.Duplicates == ReplaceDuplicates {
+			current.Val = elem
+			return nil
+		}
+
 		if cmp < 0 {
 			if current.Left == t.Nil {
 				current.Left = node
@@ -164,7 +237,9 @@ func (t *Tree[T]) Insert(elem T) {
 		}
 	}
 
+	t.updateSizes(node.Parent)
 	t.insertFixup(node)
+	return nil
 }
 
 // Search looks for a node with the specified value in the red-black tree.
@@ -214,12 +289,17 @@ func (t *Tree[T]) Height(node *Node[T]) int {
 	return 1 + max(t.Height(node.Left), t.Height(node.Right))
 }
 
-// Size calculates and returns the number of nodes in the subtree rooted at the given node.
+// Size returns the number of nodes in the subtree rooted at the given node in O(1).
 func (t *Tree[T]) Size(node *Node[T]) int {
 	if node == t.Nil {
 		return 0
 	}
-	return 1 + t.Size(node.Left) + t.Size(node.Right)
+	return size(node)
+}
+
+// Len returns the number of elements stored in the tree in O(1).
+func (t *Tree[T]) Len() int {
+	return t.Size(t.Root)
 }
 
 // Successor finds and returns the successor of the given node in the red-black tree.
@@ -257,6 +337,7 @@ func (t *Tree[T]) Predecessor(node *Node[T]) *Node[T] {
 // transplant replaces the subtree rooted at node u with the subtree rooted at node v.
 // This is a helper function used during node removal to replace one node with another.
 // The parent pointers are updated accordingly, but the children of v are not modified.
+// The sentinel Nil node is never written, so trees that share it can be modified concurrently.
 func (t *Tree[T]) transplant(u, v *Node[T]) {
 	if u.Parent == t.Nil {
 		t.Root = v
@@ -265,29 +346,33 @@ func (t *Tree[T]) transplant(u, v *Node[T]) {
 	} else {
 		u.Parent.Right = v
 	}
-	v.Parent = u.Parent
+	if v != t.Nil {
+		v.Parent = u.Parent
+	}
 }
 
 // removeFixup restores red-black tree properties after a node removal.
 // When a black node is removed, it may violate the black-height property.
 // This function rebalances the tree through recoloring and rotations to maintain all red-black properties.
 // It handles four cases for each side (left and right) of the tree.
-func (t *Tree[T]) removeFixup(x *Node[T]) {
-	for x != t.Root && x != t.Nil && x.Color == 'B' {
-		if x == x.Parent.Left {
-			w := x.Parent.Right
+// parent is the parent of x, passed explicitly because x may be the sentinel Nil node,
+// which does not record a parent.
+func (t *Tree[T]) removeFixup(x, parent *Node[T]) {
+	for x != t.Root && x.Color == 'B' {
+		if x == parent.Left {
+			w := parent.Right
 			// Case 1: w is red
 			if w.Color == 'R' {
 				w.Color = 'B'
-				x.Parent.Color = 'R'
-				t.RotateLeft(x.Parent)
-				w = x.Parent.Right
+				parent.Color = 'R'
+				t.RotateLeft(parent)
+				w = parent.Right
 			}
 
 			// Case 2: w is black and both of w's children are black
 			if w.Left.Color == 'B' && w.Right.Color == 'B' {
 				w.Color = 'R'
-				x = x.Parent
+				x, parent = parent, parent.Parent
 			} else {
 				// Case 3: w is black, w's right child is black, w's left child is red
 				if w.Right.Color == 'B' {
@@ -296,31 +381,31 @@ func (t *Tree[T]) removeFixup(x *Node[T]) {
 					}
 					w.Color = 'R'
 					t.RotateRight(w)
-					w = x.Parent.Right
+					w = parent.Right
 				}
 				// Case 4: w is black, w's right child is red
-				w.Color = x.Parent.Color
-				x.Parent.Color = 'B'
+				w.Color = parent.Color
+				parent.Color = 'B'
 				if w.Right != t.Nil {
 					w.Right.Color = 'B'
 				}
-				t.RotateLeft(x.Parent)
+				t.RotateLeft(parent)
 				x = t.Root
 			}
 		} else {
-			w := x.Parent.Left
+			w := parent.Left
 			// Case 1: w is red
 			if w.Color == 'R' {
 				w.Color = 'B'
-				x.Parent.Color = 'R'
-				t.RotateRight(x.Parent)
-				w = x.Parent.Left
+				parent.Color = 'R'
+				t.RotateRight(parent)
+				w = parent.Left
 			}
 
 			// Case 2: w is black and both of w's children are black
 			if w.Right.Color == 'B' && w.Left.Color == 'B' {
 				w.Color = 'R'
-				x = x.Parent
+				x, parent = parent, parent.Parent
 			} else {
 				// Case 3: w is black, w's left child is black, w's right child is red
 				if w.Left.Color == 'B' {
@@ -329,20 +414,29 @@ func (t *Tree[T]) removeFixup(x *Node[T]) {
 					}
 					w.Color = 'R'
 					t.RotateLeft(w)
-					w = x.Parent.Left
+					w = parent.Left
 				}
 				// Case 4: w is black, w's left child is red
-				w.Color = x.Parent.Color
-				x.Parent.Color = 'B'
+				w.Color = parent.Color
+				parent.Color = 'B'
 				if w.Left != t.Nil {
 					w.Left.Color = 'B'
 				}
-				t.RotateRight(x.Parent)
+				t.RotateRight(parent)
 				x = t.Root
 			}
 		}
 	}
-	x.Color = 'B'
+	if x != t.Nil {
+		x.Color = 'B'
+	}
+}
+
+// updateSizes recomputes subtree sizes from node up to the root after a node has been linked or unlinked.
+func (t *Tree[T]) updateSizes(node *Node[T]) {
+	for current := node; current != t.Nil; current = current.Parent {
+		t.updateSize(current)
+	}
 }
 
 // Remove deletes a node with the specified value from the red-black tree.
@@ -351,21 +445,27 @@ func (t *Tree[T]) Remove(elem T) {
 	if node == t.Nil {
 		return
 	}
+	t.removeNode(node)
+}
 
+// removeNode unlinks the given node from the red-black tree and rebalances it.
+func (t *Tree[T]) removeNode(node *Node[T]) {
 	originalColor := node.Color
 
 	if node.Left == t.Nil {
 		t.transplant(node, node.Right)
+		t.updateSizes(node.Parent)
 		if originalColor == 'B' {
-			t.removeFixup(node.Right)
+			t.removeFixup(node.Right, node.Parent)
 		}
 		return
 	}
 
 	if node.Right == t.Nil {
 		t.transplant(node, node.Left)
+		t.updateSizes(node.Parent)
 		if originalColor == 'B' {
-			t.removeFixup(node.Left)
+			t.removeFixup(node.Left, node.Parent)
 		}
 		return
 	}
@@ -373,8 +473,10 @@ func (t *Tree[T]) Remove(elem T) {
 	successor := t.Successor(node)
 	x := successor.Right
 	originalColor = successor.Color
+	xParent := successor
 
 	if successor.Parent != node {
+		xParent = successor.Parent
 		t.transplant(successor, successor.Right)
 		successor.Right = node.Right
 		successor.Right.Parent = successor
@@ -384,8 +486,36 @@ func (t *Tree[T]) Remove(elem T) {
 	successor.Left = node.Left
 	successor.Left.Parent = successor
 	successor.Color = node.Color
+	t.updateSizes(xParent)
 
 	if originalColor == 'B' {
-		t.removeFixup(x)
+		t.removeFixup(x, xParent)
+	}
+}
+
+// Count returns the number of elements in the tree equal to elem in O(log n).
+func (t *Tree[T]) Count(elem T) int {
+	atMost := 0
+	current := t.Root
+
+	for current != t.Nil {
+		if t.Comparator(elem, current.Val) < 0 {
+			current = current.Left
+		} else {
+			atMost += size(current.Left) + 1
+			current = current.Right
+		}
+	}
+
+	return atMost - t.Rank(elem)
+}
+
+// RemoveAll deletes every element equal to elem from the tree and returns how many were removed.
+func (t *Tree[T]) RemoveAll(elem T) int {
+	removed := 0
+	for t.Search(elem) != t.Nil {
+		t.Remove(elem)
+		removed++
 	}
+	return removed
 }
@@ -1,6 +1,7 @@
 package rbtree
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -252,3 +253,78 @@ func TestRBTreeRemoveAll(t *testing.T) {
 		t.Errorf("Expected tree to be empty after removing all nodes")
 	}
 }
+
+func TestRBTreeDuplicatePolicies(t *testing.T) {
+	type record struct {
+		key  int
+		name string
+	}
+	cmp := func(a, b record) int {
+		return a.key - b.key
+	}
+
+	multiset := NewTree(cmp)
+	for _, name := range []string{"a", "b", "c"} {
+		if err := multiset.Insert(record{key: 1, name: name}); err != nil {
+			t.Errorf("Unexpected error inserting duplicate into multiset: %v", err)
+		}
+	}
+	multiset.Insert(record{key: 0})
+	multiset.Insert(record{key: 2})
+	if multiset.Len() != 5 {
+		t.Errorf("Expected multiset Len 5, got %d", multiset.Len())
+	}
+	if count := multiset.Count(record{key: 1}); count != 3 {
+		t.Errorf("Expected Count 3, got %d", count)
+	}
+	if count := multiset.Count(record{key: 7}); count != 0 {
+		t.Errorf("Expected Count 0 for missing element, got %d", count)
+	}
+
+	reject := NewTree(cmp, WithDuplicates(RejectDuplicates))
+	reject.Insert(record{key: 1, name: "first"})
+	if err := reject.Insert(record{key: 1, name: "second"}); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("Expected ErrDuplicate, got %v", err)
+	}
+	if reject.Len() != 1 {
+		t.Errorf("Expected Len 1 after rejected insert, got %d", reject.Len())
+	}
+	if node := reject.Search(record{key: 1}); node.Val.name != "first" {
+		t.Errorf("Expected rejected insert to keep original value, got %q", node.Val.name)
+	}
+
+	replace := NewTree(cmp, WithDuplicates(ReplaceDuplicates))
+	replace.Insert(record{key: 1, name: "first"})
+	if err := replace.Insert(record{key: 1, name: "second"}); err != nil {
+		t.Errorf("Unexpected error replacing value: %v", err)
+	}
+	if replace.Len() != 1 {
+		t.Errorf("Expected Len 1 after replace, got %d", replace.Len())
+	}
+	if node := replace.Search(record{key: 1}); node.Val.name != "second" {
+		t.Errorf("Expected replaced value \"second\", got %q", node.Val.name)
+	}
+}
+
+func TestRBTreeRemoveAllDuplicates(t *testing.T) {
+	cmp := func(a, b int) int {
+		return a - b
+	}
+	tree := NewTree(cmp)
+	for _, v := range []int{5, 3, 5, 8, 5, 1, 5, 9, 5} {
+		tree.Insert(v)
+	}
+
+	if removed := tree.RemoveAll(5); removed != 5 {
+		t.Errorf("Expected RemoveAll to remove 5 elements, got %d", removed)
+	}
+	if count := tree.Count(5); count != 0 {
+		t.Errorf("Expected Count 0 after RemoveAll, got %d", count)
+	}
+	if tree.Len() != 4 {
+		t.Errorf("Expected Len 4 after RemoveAll, got %d", tree.Len())
+	}
+	if removed := tree.RemoveAll(42); removed != 0 {
+		t.Errorf("Expected RemoveAll of missing element to remove 0, got %d", removed)
+	}
+}
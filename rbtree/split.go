@@ -0,0 +1,209 @@
+package rbtree
+
+import "errors"
+
+// ErrOverlap is returned by Join when an element of the left tree is greater than an element of the right tree.
+var ErrOverlap = errors.New("trees overlap")
+
+// blackHeight returns the number of black nodes on any path from the root down to the sentinel Nil node.
+func (t *Tree[T]) blackHeight() int {
+	height := 0
+	for node := t.Root; node != t.Nil; node = node.Left {
+		if node.Color == 'B' {
+			height++
+		}
+	}
+	return height
+}
+
+// subtree detaches node from its parent and returns it as the root of a standalone tree sharing
+// t's sentinel, comparator and duplicate policy. height is the black height node had in place;
+// the returned black height accounts for a red root being recolored black.
+func (t *Tree[T]) subtree(node *Node[T], height int) (*Tree[T], int) {
+	sub := &Tree[T]{Root: node, Comparator: t.Comparator, Nil: t.Nil, Duplicates: t.Duplicates}
+	if node != t.Nil {
+		node.Parent = t.Nil
+		if node.Color == 'R' {
+			node.Color = 'B'
+			height++
+		}
+	}
+	return sub, height
+}
+
+// joinPivot links left, the single node k and right into one tree, where every element of left
+// precedes k and k precedes every element of right. lh and rh are the black heights of left and right.
+// k is hung off the spine of the taller tree at the first black node whose black height matches the
+// shorter tree, so only O(|lh - rh| + 1) nodes are visited before insertFixup restores the colors.
+// It returns the joined tree, which reuses the taller input, and its black height.
+func joinPivot[T any](left *Tree[T], lh int, k *Node[T], right *Tree[T], rh int) (*Tree[T], int) {
+	k.Color = 'R'
+
+	if lh >= rh {
+		parent, current, height := left.Nil, left.Root, lh
+		for current.Color != 'B' || height != rh {
+			if current.Color == 'B' {
+				height--
+			}
+			parent, current = current, current.Right
+		}
+
+		k.Left, k.Right, k.Parent = current, right.Root, parent
+		if current != left.Nil {
+			current.Parent = k
+		}
+		if right.Root != left.Nil {
+			right.Root.Parent = k
+		}
+		if parent == left.Nil {
+			left.Root = k
+		} else {
+			parent.Right = k
+		}
+
+		left.updateSize(k)
+		left.updateSizes(parent)
+		if left.insertFixup(k) {
+			lh++
+		}
+		return left, lh
+	}
+
+	parent, current, height := right.Nil, right.Root, rh
+	for current.Color != 'B' || height != lh {
+		if current.Color == 'B' {
+			height--
+		}
+		parent, current = current, current.Left
+	}
+
+	k.Left, k.Right, k.Parent = left.Root, current, parent
+	if current != right.Nil {
+		current.Parent = k
+	}
+	if left.Root != right.Nil {
+		left.Root.Parent = k
+	}
+	if parent == right.Nil {
+		right.Root = k
+	} else {
+		parent.Left = k
+	}
+
+	right.updateSize(k)
+	right.updateSizes(parent)
+	if right.insertFixup(k) {
+		rh++
+	}
+	return right, rh
+}
+
+// split divides the tree rooted at node, whose black height is height, into the elements less than key
+// and the elements greater than or equal to key, returning both trees with their black heights.
+func (t *Tree[T]) split(node *Node[T], height int, key T) (*Tree[T], int, *Tree[T], int) {
+	if node == t.Nil {
+		empty, _ := t.subtree(t.Nil, 0)
+		other, _ := t.subtree(t.Nil, 0)
+		return empty, 0, other, 0
+	}
+
+	if node.Color == 'B' {
+		height--
+	}
+	left, lh := t.subtree(node.Left, height)
+	right, rh := t.subtree(node.Right, height)
+	node.Left, node.Right, node.Parent, node.size = t.Nil, t.Nil, t.Nil, 1
+
+	if t.Comparator(node.Val, key) >= 0 {
+		less, lessHeight, rest, restHeight := t.split(left.Root, lh, key)
+		greater, greaterHeight := joinPivot(rest, restHeight, node, right, rh)
+		return less, lessHeight, greater, greaterHeight
+	}
+
+	rest, restHeight, greater, greaterHeight := t.split(right.Root, rh, key)
+	less, lessHeight := joinPivot(left, lh, node, rest, restHeight)
+	return less, lessHeight, greater, greaterHeight
+}
+
+// Split divides the tree into two valid red-black trees: one holding the elements less than key
+// and one holding the elements greater than or equal to key. It runs in O(log n).
+// The nodes are moved, not copied, so t is left empty. Both trees share t's sentinel Nil node,
+// comparator and duplicate policy. The sentinel is never written, so the two trees are independent
+// and may be used from different goroutines.
+func (t *Tree[T]) Split(key T) (*Tree[T], *Tree[T]) {
+	root, height := t.Root, t.blackHeight()
+	t.Root = t.Nil
+
+	less, _, greater, _ := t.split(root, height, key)
+	return less, greater
+}
+
+// adopt re-points every link to the tree's sentinel Nil node at sentinel instead.
+// It is used by Join to merge trees that were not split from the same tree, and costs O(n).
+func (t *Tree[T]) adopt(sentinel *Node[T]) {
+	var relink func(node *Node[T])
+	relink = func(node *Node[T]) {
+		if node.Left == t.Nil {
+			node.Left = sentinel
+		} else {
+			relink(node.Left)
+		}
+		if node.Right == t.Nil {
+			node.Right = sentinel
+		} else {
+			relink(node.Right)
+		}
+	}
+
+	if t.Root == t.Nil {
+		t.Root = sentinel
+	} else {
+		t.Root.Parent = sentinel
+		relink(t.Root)
+	}
+	t.Nil = sentinel
+}
+
+// Join concatenates left and right into a single valid red-black tree. Every element of left must be
+// less than or equal to every element of right, otherwise ErrOverlap is returned and neither tree is modified.
+// The joined tree uses left's comparator and duplicate policy.
+//
+// Join runs in O(log n) only when both trees share a sentinel Nil node, as the two halves returned by Split do.
+// This is a known limitation: trees built independently have their own sentinels, and the leaves of the
+// smaller tree must first be re-linked to the other's, which costs O(min(n, m)).
+// The nodes are moved, not copied, so left and right are left empty.
+func Join[T any](left, right *Tree[T]) (*Tree[T], error) {
+	if left.Root != left.Nil && right.Root != right.Nil {
+		if left.Comparator(left.Maximum(left.Root).Val, right.Minimum(right.Root).Val) > 0 {
+			return nil, ErrOverlap
+		}
+	}
+
+	if right.Nil != left.Nil {
+		if left.Len() < right.Len() {
+			left.adopt(right.Nil)
+		} else {
+			right.adopt(left.Nil)
+		}
+	}
+
+	sentinel := left.Nil
+	joined := &Tree[T]{Root: left.Root, Comparator: left.Comparator, Nil: sentinel, Duplicates: left.Duplicates}
+	if right.Root != sentinel {
+		pivot := right.Minimum(right.Root)
+		right.removeNode(pivot)
+		pivot.Left, pivot.Right, pivot.Parent, pivot.size = sentinel, sentinel, sentinel, 1
+
+		rest := &Tree[T]{Root: right.Root, Nil: sentinel}
+		if rest.Root != rest.Nil {
+			rest.Root.Parent = rest.Nil
+		}
+
+		result, _ := joinPivot(joined, left.blackHeight(), pivot, rest, rest.blackHeight())
+		joined.Root = result.Root
+	}
+
+	left.Root = left.Nil
+	right.Root = right.Nil
+	return joined, nil
+}
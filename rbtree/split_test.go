@@ -0,0 +1,219 @@
+package rbtree
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSplit(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+
+	for n := 0; n <= 40; n++ {
+		for _, key := range []int{-1, 0, n / 2, n, n + 1} {
+			tree := newIntTree()
+			values := rng.Perm(n)
+			for _, v := range values {
+				tree.Insert(v)
+			}
+
+			less, greater := tree.Split(key)
+			if tree.Len() != 0 {
+				t.Errorf("n=%d key=%d: expected split tree to be empty, got Len %d", n, key, tree.Len())
+			}
+			if err := less.Validate(); err != nil {
+				t.Fatalf("n=%d key=%d: left tree: %v", n, key, err)
+			}
+			if err := greater.Validate(); err != nil {
+				t.Fatalf("n=%d key=%d: right tree: %v", n, key, err)
+			}
+
+			var expectedLess, expectedGreater []int
+			for v := range n {
+				if v < key {
+					expectedLess = append(expectedLess, v)
+				} else {
+					expectedGreater = append(expectedGreater, v)
+				}
+			}
+			if got := slices.Collect(less.Ascend()); !slices.Equal(got, expectedLess) {
+				t.Errorf("n=%d key=%d: left = %v, expected %v", n, key, got, expectedLess)
+			}
+			if got := slices.Collect(greater.Ascend()); !slices.Equal(got, expectedGreater) {
+				t.Errorf("n=%d key=%d: right = %v, expected %v", n, key, got, expectedGreater)
+			}
+
+			// The halves must remain fully usable.
+			less.Insert(-10)
+			greater.Remove(key)
+			if err := less.Validate(); err != nil {
+				t.Fatalf("n=%d key=%d: left tree after insert: %v", n, key, err)
+			}
+			if err := greater.Validate(); err != nil {
+				t.Fatalf("n=%d key=%d: right tree after remove: %v", n, key, err)
+			}
+		}
+	}
+}
+
+func TestSplitWithDuplicates(t *testing.T) {
+	tree := newIntTree(5, 3, 5, 8, 5, 1, 5)
+
+	less, greater := tree.Split(5)
+	if got := slices.Collect(less.Ascend()); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("left = %v, expected [1 3]", got)
+	}
+	if got := slices.Collect(greater.Ascend()); !slices.Equal(got, []int{5, 5, 5, 5, 8}) {
+		t.Errorf("right = %v, expected [5 5 5 5 8]", got)
+	}
+}
+
+func TestJoin(t *testing.T) {
+	rng := rand.New(rand.NewSource(13))
+
+	for _, sizes := range [][2]int{{0, 0}, {0, 5}, {5, 0}, {1, 1}, {1, 50}, {50, 1}, {20, 20}, {3, 200}, {200, 3}} {
+		left, right := newIntTree(), newIntTree()
+		for _, v := range rng.Perm(sizes[0]) {
+			left.Insert(v)
+		}
+		for _, v := range rng.Perm(sizes[1]) {
+			right.Insert(sizes[0] + v)
+		}
+
+		joined, err := Join(left, right)
+		if err != nil {
+			t.Fatalf("sizes=%v: unexpected error: %v", sizes, err)
+		}
+		if err := joined.Validate(); err != nil {
+			t.Fatalf("sizes=%v: %v", sizes, err)
+		}
+
+		expected := make([]int, sizes[0]+sizes[1])
+		for i := range expected {
+			expected[i] = i
+		}
+		if got := slices.Collect(joined.Ascend()); len(expected) > 0 && !slices.Equal(got, expected) {
+			t.Errorf("sizes=%v: joined = %v, expected %v", sizes, got, expected)
+		}
+		if left.Len() != 0 || right.Len() != 0 {
+			t.Errorf("sizes=%v: expected inputs to be emptied", sizes)
+		}
+
+		joined.Insert(-1)
+		joined.Remove(0)
+		if err := joined.Validate(); err != nil {
+			t.Fatalf("sizes=%v: after updates: %v", sizes, err)
+		}
+	}
+}
+
+func TestSplitThenJoin(t *testing.T) {
+	rng := rand.New(rand.NewSource(17))
+	tree := newIntTree()
+	for _, v := range rng.Perm(500) {
+		tree.Insert(v)
+	}
+
+	for i := 0; i < 50; i++ {
+		less, greater := tree.Split(rng.Intn(520) - 10)
+		if less.Nil != greater.Nil {
+			t.Fatal("Expected both halves to share the sentinel Nil node")
+		}
+
+		var err error
+		tree, err = Join(less, greater)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("Round %d: %v", i, err)
+		}
+		if tree.Len() != 500 {
+			t.Fatalf("Round %d: expected Len 500, got %d", i, tree.Len())
+		}
+	}
+}
+
+func TestJoinRelinksSmallerTree(t *testing.T) {
+	for _, sizes := range [][2]int{{3, 200}, {200, 3}, {10, 10}} {
+		left, right := newIntTree(), newIntTree()
+		for v := range sizes[0] {
+			left.Insert(v)
+		}
+		for v := range sizes[1] {
+			right.Insert(sizes[0] + v)
+		}
+		expectedNil := left.Nil
+		if sizes[0] < sizes[1] {
+			expectedNil = right.Nil
+		}
+
+		joined, err := Join(left, right)
+		if err != nil {
+			t.Fatalf("sizes=%v: unexpected error: %v", sizes, err)
+		}
+		if joined.Nil != expectedNil {
+			t.Errorf("sizes=%v: expected the joined tree to keep the larger tree's sentinel", sizes)
+		}
+		if err := joined.Validate(); err != nil {
+			t.Fatalf("sizes=%v: %v", sizes, err)
+		}
+		if joined.Len() != sizes[0]+sizes[1] {
+			t.Errorf("sizes=%v: expected Len %d, got %d", sizes, sizes[0]+sizes[1], joined.Len())
+		}
+	}
+}
+
+func TestSplitHalvesConcurrently(t *testing.T) {
+	rng := rand.New(rand.NewSource(23))
+	tree := newIntTree()
+	for _, v := range rng.Perm(20000) {
+		tree.Insert(v)
+	}
+	less, greater := tree.Split(10000)
+
+	// Each half is modified from its own goroutine; run with -race to check that the
+	// shared sentinel Nil node is never written.
+	var wg sync.WaitGroup
+	for i, half := range []*Tree[int]{less, greater} {
+		seed := int64(i)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(seed))
+			for _, v := range slices.Collect(half.Ascend()) {
+				if rng.Intn(2) == 0 {
+					half.Remove(v)
+				} else {
+					half.Insert(v)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := less.Validate(); err != nil {
+		t.Fatalf("left tree: %v", err)
+	}
+	if err := greater.Validate(); err != nil {
+		t.Fatalf("right tree: %v", err)
+	}
+}
+
+func TestJoinRejectsOverlap(t *testing.T) {
+	left := newIntTree(1, 5, 10)
+	right := newIntTree(7, 12)
+
+	joined, err := Join(left, right)
+	if !errors.Is(err, ErrOverlap) {
+		t.Errorf("Expected ErrOverlap, got %v", err)
+	}
+	if joined != nil {
+		t.Errorf("Expected nil tree on error, got %v", joined)
+	}
+	if left.Len() != 3 || right.Len() != 2 {
+		t.Error("Expected inputs to be untouched on error")
+	}
+}
@@ -0,0 +1,107 @@
+package rbtree
+
+import (
+	"fmt"
+	"strings"
+)
+
+// validator carries the state of an in-order walk performed by Validate.
+type validator[T any] struct {
+	tree *Tree[T]
+	prev *Node[T]
+	path []string
+}
+
+// Validate checks that the tree satisfies the red-black and binary search tree invariants:
+// the sentinel Nil node is black and childless, the root is black, every node is either red
+// or black, no red node has a red child, every path has the same number of black nodes,
+// Parent pointers match the links that reach each node, values are in order according to the
+// Comparator, and cached subtree sizes are correct. It returns nil for a valid tree, or an error
+// describing the first violation found together with the path from the root to the offending node.
+func (t *Tree[T]) Validate() error {
+	if t.Nil != nil {
+		if t.Nil.Color != 'B' {
+			return fmt.Errorf("sentinel Nil node is %c, expected B", t.Nil.Color)
+		}
+		if t.Nil.Left != t.Nil || t.Nil.Right != t.Nil {
+			return fmt.Errorf("sentinel Nil node has children")
+		}
+		if t.Nil.Parent != t.Nil {
+			return fmt.Errorf("sentinel Nil node has a parent")
+		}
+		if t.Nil.size != 0 {
+			return fmt.Errorf("sentinel Nil node has size %d, expected 0", t.Nil.size)
+		}
+	}
+
+	if t.Root == t.Nil {
+		return nil
+	}
+	if t.Root.Color != 'B' {
+		return fmt.Errorf("root is %c, expected B", t.Root.Color)
+	}
+	if t.Root.Parent != t.Nil {
+		return fmt.Errorf("root has a parent")
+	}
+
+	v := &validator[T]{tree: t, prev: t.Nil, path: []string{"root"}}
+	_, err := v.check(t.Root)
+	return err
+}
+
+// fail formats an error for the node at the validator's current path.
+func (v *validator[T]) fail(format string, args ...any) error {
+	return fmt.Errorf("node at %s: %s", strings.Join(v.path, "."), fmt.Sprintf(format, args...))
+}
+
+// check validates the subtree rooted at node and returns its black height.
+func (v *validator[T]) check(node *Node[T]) (int, error) {
+	t := v.tree
+	if node == t.Nil {
+		return 0, nil
+	}
+
+	if node.Color != 'R' && node.Color != 'B' {
+		return 0, v.fail("invalid color %q", node.Color)
+	}
+	if node.Left != t.Nil && node.Left.Parent != node {
+		return 0, v.fail("left child's Parent does not point back to it")
+	}
+	if node.Right != t.Nil && node.Right.Parent != node {
+		return 0, v.fail("right child's Parent does not point back to it")
+	}
+	if node.Color == 'R' && (node.Left.Color == 'R' || node.Right.Color == 'R') {
+		return 0, v.fail("red node has a red child")
+	}
+
+	v.path = append(v.path, "L")
+	left, err := v.check(node.Left)
+	v.path = v.path[:len(v.path)-1]
+	if err != nil {
+		return 0, err
+	}
+
+	if v.prev != t.Nil && t.Comparator(v.prev.Val, node.Val) > 0 {
+		return 0, v.fail("value is out of order with its in-order predecessor")
+	}
+	v.prev = node
+
+	v.path = append(v.path, "R")
+	right, err := v.check(node.Right)
+	v.path = v.path[:len(v.path)-1]
+	if err != nil {
+		return 0, err
+	}
+
+	if left != right {
+		return 0, v.fail("black height of left subtree (%d) differs from right subtree (%d)", left, right)
+	}
+	if expected := size(node.Left) + size(node.Right) + 1; node.size != expected {
+		return 0, v.fail("subtree size is %d, expected %d", node.size, expected)
+	}
+
+	if node.Color == 'B' {
+		left++
+	}
+	return left, nil
+}
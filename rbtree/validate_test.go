@@ -0,0 +1,93 @@
+package rbtree
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestValidateAfterRandomOperations(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	tree := newIntTree()
+
+	if err := tree.Validate(); err != nil {
+		t.Fatalf("Expected empty tree to be valid, got %v", err)
+	}
+
+	for i := 0; i < 3000; i++ {
+		v := rng.Intn(500)
+		if rng.Intn(3) == 0 {
+			tree.Remove(v)
+		} else {
+			tree.Insert(v)
+		}
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("Step %d: %v", i, err)
+		}
+	}
+}
+
+func TestValidateDetectsViolations(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(tree *Tree[int])
+		want    string
+	}{
+		{
+			name:    "red root",
+			corrupt: func(tree *Tree[int]) { tree.Root.Color = 'R' },
+			want:    "root is R",
+		},
+		{
+			name: "red-red edge",
+			corrupt: func(tree *Tree[int]) {
+				tree.Root.Left.Color = 'R'
+				tree.Root.Left.Left.Color = 'R'
+			},
+			want: "node at root.L: red node has a red child",
+		},
+		{
+			name:    "black height mismatch",
+			corrupt: func(tree *Tree[int]) { tree.Root.Left.Left.Color = 'B' },
+			want:    "node at root.L: black height",
+		},
+		{
+			name:    "broken parent link",
+			corrupt: func(tree *Tree[int]) { tree.Root.Right.Left.Parent = tree.Root },
+			want:    "node at root.R: left child's Parent",
+		},
+		{
+			name:    "out of order value",
+			corrupt: func(tree *Tree[int]) { tree.Root.Right.Left.Val = 1 },
+			want:    "node at root.R.L: value is out of order",
+		},
+		{
+			name:    "sentinel recolored",
+			corrupt: func(tree *Tree[int]) { tree.Nil.Color = 'R' },
+			want:    "sentinel Nil node",
+		},
+		{
+			name:    "stale subtree size",
+			corrupt: func(tree *Tree[int]) { tree.Root.Right.size = 42 },
+			want:    "node at root.R: subtree size is 42",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := newIntTree(20, 10, 30, 5, 15, 25, 35)
+			if err := tree.Validate(); err != nil {
+				t.Fatalf("Expected valid tree before corruption, got %v", err)
+			}
+
+			tt.corrupt(tree)
+			err := tree.Validate()
+			if err == nil {
+				t.Fatal("Expected Validate to report a violation")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %q", tt.want, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package stack
+
+import "sync"
+
+// SyncStack is a Stack that is safe for concurrent use by multiple goroutines.
+// Besides the Stack operations it offers compound operations that check and act
+// under a single lock, avoiding IsEmpty/Pop style check-then-act races.
+type SyncStack[T any] struct {
+	mu sync.Mutex
+	s  *Stack[T]
+}
+
+func NewSyncStack[T any](size uint) *SyncStack[T] {
+	return &SyncStack[T]{s: NewStack[T](size)}
+}
+
+func (s *SyncStack[T]) IsEmpty() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.IsEmpty()
+}
+
+func (s *SyncStack[T]) IsFull() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.IsFull()
+}
+
+func (s *SyncStack[T]) Push(elem T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.Push(elem)
+}
+
+func (s *SyncStack[T]) Pop() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.Pop()
+}
+
+func (s *SyncStack[T]) Peek() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.Peek()
+}
+
+// PushIfNotFull pushes elem and reports true, or reports false if the stack is full.
+func (s *SyncStack[T]) PushIfNotFull(elem T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.s.Push(elem) == nil
+}
+
+// TryPop removes and returns the top element, or reports false if the stack is empty.
+func (s *SyncStack[T]) TryPop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	elem, err := s.s.Pop()
+	return elem, err == nil
+}
+
+// DrainTo pops every element in LIFO order, appends them to dst and returns the extended slice.
+func (s *SyncStack[T]) DrainTo(dst []T) []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for !s.s.IsEmpty() {
+		elem, _ := s.s.Pop()
+		dst = append(dst, elem)
+	}
+	return dst
+}
@@ -0,0 +1,87 @@
+package stack
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSyncStackCompoundOperations(t *testing.T) {
+	stack := NewSyncStack[int](2)
+
+	if _, ok := stack.TryPop(); ok {
+		t.Error("Expected TryPop on empty stack to report false")
+	}
+
+	if !stack.PushIfNotFull(1) || !stack.PushIfNotFull(2) {
+		t.Error("Expected PushIfNotFull to succeed while there is room")
+	}
+	if stack.PushIfNotFull(3) {
+		t.Error("Expected PushIfNotFull on full stack to report false")
+	}
+	if !stack.IsFull() {
+		t.Error("Expected stack to be full")
+	}
+
+	top, err := stack.Peek()
+	if err != nil || top != 2 {
+		t.Errorf("Expected Peek to return 2, got %d, %v", top, err)
+	}
+
+	drained := stack.DrainTo(nil)
+	if !slices.Equal(drained, []int{2, 1}) {
+		t.Errorf("Expected DrainTo to return [2 1], got %v", drained)
+	}
+	if !stack.IsEmpty() {
+		t.Error("Expected stack to be empty after DrainTo")
+	}
+}
+
+func TestSyncStackConcurrentPushPop(t *testing.T) {
+	const workers, perWorker = 8, 200
+	stack := NewSyncStack[int](workers * perWorker)
+
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				if err := stack.Push(w*perWorker + i); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if !stack.IsFull() {
+		t.Fatal("Expected stack to be full after all pushes")
+	}
+
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				elem, ok := stack.TryPop()
+				if !ok {
+					return
+				}
+				mu.Lock()
+				if seen[elem] {
+					t.Errorf("Element %d popped twice", elem)
+				}
+				seen[elem] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != workers*perWorker {
+		t.Errorf("Expected %d distinct elements, got %d", workers*perWorker, len(seen))
+	}
+}
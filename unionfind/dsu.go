@@ -0,0 +1,133 @@
+// Package unionfind implements a disjoint-set union (union-find) structure that keeps track
+// of a partition of elements into disjoint sets.
+package unionfind
+
+import "iter"
+
+// DSU is a disjoint-set union over comparable elements, using path compression and union by rank
+// so that Find and Union run in amortised near-constant time.
+// Elements are added explicitly with Add or implicitly the first time they are passed to Union.
+// Queries never add elements: an absent element behaves as if it were in a set of its own that has no members.
+type DSU[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	size   map[T]int // only meaningful for set representatives
+	order  []T       // elements in insertion order, so Sets is deterministic
+	sets   int
+}
+
+// NewDSU creates and returns an empty disjoint-set union.
+func NewDSU[T comparable]() *DSU[T] {
+	return &DSU[T]{
+		parent: make(map[T]T),
+		rank:   make(map[T]int),
+		size:   make(map[T]int),
+	}
+}
+
+// Add inserts x as a singleton set and reports whether it was not already present.
+func (d *DSU[T]) Add(x T) bool {
+	if _, ok := d.parent[x]; ok {
+		return false
+	}
+	d.parent[x] = x
+	d.size[x] = 1
+	d.order = append(d.order, x)
+	d.sets++
+	return true
+}
+
+// Len returns the number of elements in the structure.
+func (d *DSU[T]) Len() int {
+	return len(d.parent)
+}
+
+// Count returns the number of disjoint sets.
+func (d *DSU[T]) Count() int {
+	return d.sets
+}
+
+// Find returns the representative of the set containing x, compressing the path it walks.
+// Two elements are in the same set if and only if they have the same representative.
+// If x is not in the structure, Find returns x itself without adding it.
+func (d *DSU[T]) Find(x T) T {
+	if _, ok := d.parent[x]; !ok {
+		return x
+	}
+	return d.find(x)
+}
+
+// find returns the representative of the set containing x, which must be present.
+func (d *DSU[T]) find(x T) T {
+	root := x
+	for d.parent[root] != root {
+		root = d.parent[root]
+	}
+	for x != root {
+		next := d.parent[x]
+		d.parent[x] = root
+		x = next
+	}
+	return root
+}
+
+// Union merges the sets containing x and y and reports whether they were previously disjoint.
+// Elements that are not yet in the structure are added first.
+func (d *DSU[T]) Union(x, y T) bool {
+	d.Add(x)
+	d.Add(y)
+	rx, ry := d.find(x), d.find(y)
+	if rx == ry {
+		return false
+	}
+
+	if d.rank[rx] < d.rank[ry] {
+		rx, ry = ry, rx
+	}
+	if d.rank[rx] == d.rank[ry] {
+		d.rank[rx]++
+	}
+	d.parent[ry] = rx
+	d.size[rx] += d.size[ry]
+	delete(d.size, ry)
+	delete(d.rank, ry)
+	d.sets--
+	return true
+}
+
+// Connected reports whether x and y are in the same set. It returns false if either is absent.
+func (d *DSU[T]) Connected(x, y T) bool {
+	_, okX := d.parent[x]
+	_, okY := d.parent[y]
+	return okX && okY && d.find(x) == d.find(y)
+}
+
+// SetSize returns the number of elements in the set containing x, or 0 if x is absent.
+func (d *DSU[T]) SetSize(x T) int {
+	if _, ok := d.parent[x]; !ok {
+		return 0
+	}
+	return d.size[d.find(x)]
+}
+
+// Sets returns an iterator over the disjoint sets. Sets are yielded in the order their first
+// element was added, and the elements of each set are listed in insertion order.
+func (d *DSU[T]) Sets() iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		groups := make(map[T][]T, d.sets)
+		var roots []T
+		for _, x := range d.order {
+			root := d.find(x)
+			if _, ok := groups[root]; !ok {
+				roots = append(roots, root)
+			}
+			groups[root] = append(groups[root], x)
+		}
+
+		for _, root := range roots {
+			if !yield(groups[root]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package unionfind
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDSUUnionAndFind(t *testing.T) {
+	d := NewDSU[string]()
+	for _, x := range []string{"a", "b", "c", "d", "e"} {
+		if !d.Add(x) {
+			t.Errorf("Expected Add(%s) to insert a new element", x)
+		}
+	}
+	if d.Add("a") {
+		t.Error("Expected Add of an existing element to report false")
+	}
+
+	if !d.Union("a", "b") || !d.Union("c", "d") || !d.Union("b", "d") {
+		t.Error("Expected unions of disjoint sets to report true")
+	}
+	if d.Union("a", "c") {
+		t.Error("Expected union of already connected elements to report false")
+	}
+
+	tests := []struct {
+		x, y      string
+		connected bool
+	}{
+		{"a", "d", true},
+		{"b", "c", true},
+		{"a", "e", false},
+		{"e", "e", true},
+	}
+	for _, tt := range tests {
+		if got := d.Connected(tt.x, tt.y); got != tt.connected {
+			t.Errorf("Connected(%s, %s) = %v, expected %v", tt.x, tt.y, got, tt.connected)
+		}
+	}
+
+	if d.Count() != 2 {
+		t.Errorf("Expected 2 sets, got %d", d.Count())
+	}
+	if d.SetSize("c") != 4 || d.SetSize("e") != 1 {
+		t.Errorf("Expected set sizes 4 and 1, got %d and %d", d.SetSize("c"), d.SetSize("e"))
+	}
+}
+
+func TestDSUQueriesDoNotAdd(t *testing.T) {
+	d := NewDSU[int]()
+	if d.Find(7) != 7 {
+		t.Error("Expected an unknown element to be its own representative")
+	}
+	if d.Connected(7, 8) || d.Connected(7, 7) {
+		t.Error("Expected unknown elements not to be connected")
+	}
+	if d.SetSize(7) != 0 {
+		t.Errorf("Expected SetSize 0 for an unknown element, got %d", d.SetSize(7))
+	}
+	if d.Len() != 0 || d.Count() != 0 {
+		t.Errorf("Expected queries not to add elements, got %d elements in %d sets", d.Len(), d.Count())
+	}
+
+	d.Union(1, 2)
+	if d.Len() != 2 || d.Count() != 1 {
+		t.Errorf("Expected Union to add 2 elements in 1 set, got %d in %d", d.Len(), d.Count())
+	}
+	if d.Connected(1, 3) {
+		t.Error("Expected a known and an unknown element not to be connected")
+	}
+}
+
+func TestDSUSets(t *testing.T) {
+	d := NewDSU[int]()
+	for i := range 10 {
+		d.Add(i)
+	}
+	for i := 2; i < 10; i++ {
+		d.Union(i, i%3)
+	}
+
+	var sets [][]int
+	for set := range d.Sets() {
+		sets = append(sets, set)
+	}
+	expected := [][]int{{0, 3, 6, 9}, {1, 4, 7}, {2, 5, 8}}
+	if !slices.EqualFunc(sets, expected, slices.Equal) {
+		t.Errorf("Expected %v, got %v", expected, sets)
+	}
+}
+
+func TestDSULongChain(t *testing.T) {
+	const n = 100000
+	d := NewDSU[int]()
+	for i := 1; i < n; i++ {
+		d.Union(i-1, i)
+	}
+	if d.Count() != 1 || d.SetSize(0) != n {
+		t.Errorf("Expected a single set of %d elements, got %d sets of size %d", n, d.Count(), d.SetSize(0))
+	}
+	if !d.Connected(0, n-1) {
+		t.Error("Expected the ends of the chain to be connected")
+	}
+}